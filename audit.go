@@ -0,0 +1,20 @@
+package ekasdk
+
+import "github.com/eka-care/eka-sdk-go/internal/interfaces"
+
+// AuditSink receives an audit entry for every ABDM call
+type AuditSink = interfaces.AuditSink
+
+// AuditEntry records who accessed or modified which patient's ABHA data
+type AuditEntry = interfaces.AuditEntry
+
+// WithAuditSink records an audit entry for every login, registration and
+// profile call, e.g. with audit.OpenFileSink, including calls rejected before
+// they are sent. Sink errors do not fail the call; install the OnAuditError
+// hook to be told about them, as they are otherwise only logged when logging
+// is enabled.
+func WithAuditSink(sink AuditSink) Option {
+	return func(opts *ClientOptions) {
+		opts.AuditSink = sink
+	}
+}
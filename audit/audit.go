@@ -0,0 +1,33 @@
+// Package audit records a compliance trail of ABDM calls that access or
+// modify patient identity data.
+//
+// Install a sink on the client and every login, registration and profile
+// call produces an Entry with the timestamp, operation, partner user ID, HIP
+// ID, patient OID, outcome and request ID. Entries never carry OTPs, Aadhaar
+// numbers, tokens or request bodies.
+//
+//	sink, err := audit.OpenFileSink("/var/log/eka/abdm-audit.jsonl", audit.WithKey(key))
+//	if err != nil {
+//		return err
+//	}
+//	defer sink.Close()
+//	client := ekasdk.New(ekasdk.WithAuditSink(sink))
+package audit
+
+import "github.com/eka-care/eka-sdk-go/internal/interfaces"
+
+// Sink receives an audit entry for every ABDM call
+type Sink = interfaces.AuditSink
+
+// Entry is a single audit record
+type Entry = interfaces.AuditEntry
+
+// Outcome is the result of an audited call
+type Outcome = interfaces.AuditOutcome
+
+// Audit outcomes
+const (
+	OutcomeSuccess = interfaces.AuditOutcomeSuccess
+	OutcomeFailure = interfaces.AuditOutcomeFailure
+	OutcomeError   = interfaces.AuditOutcomeError
+)
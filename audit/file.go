@@ -0,0 +1,256 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"sync"
+)
+
+// ErrTampered is matched, using errors.Is, by Verify errors for audit logs
+// whose hash chain is broken
+var ErrTampered = errors.New("audit log tampered")
+
+// maxLineSize bounds the size of a single audit record
+const maxLineSize = 1 << 20
+
+// FileOption configures a FileSink and the verification of its log
+type FileOption func(*fileOptions)
+
+// fileOptions holds the settings of a FileSink
+type fileOptions struct {
+	key []byte
+}
+
+// WithKey chains records with HMAC-SHA256 under key instead of a plain
+// SHA-256 hash. Without a key anyone who can edit the log can recompute
+// every hash, so the chain only detects accidental corruption; with a key
+// kept away from the log, edits cannot be hidden without it. The same key
+// must be passed to Verify.
+func WithKey(key []byte) FileOption {
+	return func(o *fileOptions) {
+		o.key = key
+	}
+}
+
+// newFileOptions applies opts
+func newFileOptions(opts []FileOption) fileOptions {
+	var o fileOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// newHash returns the hash used to chain records
+func (o fileOptions) newHash() hash.Hash {
+	if len(o.key) > 0 {
+		return hmac.New(sha256.New, o.key)
+	}
+	return sha256.New()
+}
+
+// record is the line written to the audit log. Hash is the SHA-256, or
+// HMAC-SHA256 when a key is set, of the line encoded without it, which
+// includes the hash of the previous record.
+type record struct {
+	Seq uint64 `json:"seq"`
+	Entry
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash,omitempty"`
+}
+
+// hash returns the hash of the record
+func (r record) hash(o fileOptions) (string, error) {
+	r.Hash = ""
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	h := o.newHash()
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// FileSink appends audit entries to a JSON lines file. Every record carries
+// the hash of the previous one, so that edited, removed or reordered records
+// are detected by Verify; see WithKey for protection against deliberate
+// tampering.
+type FileSink struct {
+	opts fileOptions
+
+	mu        sync.Mutex
+	file      *os.File
+	seq       uint64
+	prevHash  string
+	truncated []byte
+}
+
+// OpenFileSink opens or creates an append-only audit log and resumes its hash
+// chain. A partial last line, left by a crash in the middle of a write, was
+// never acknowledged by Record; it is removed so that the chain continues
+// from the last complete record, and is available from Truncated.
+func OpenFileSink(path string, opts ...FileOption) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+
+	last, end, partial, err := lastRecord(file)
+	if err == nil && len(partial) > 0 {
+		err = file.Truncate(end)
+	}
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to read audit log %s: %w", path, err)
+	}
+
+	s := &FileSink{opts: newFileOptions(opts), file: file, truncated: partial}
+	if last != nil {
+		s.seq = last.Seq
+		s.prevHash = last.Hash
+	}
+	return s, nil
+}
+
+// Truncated returns the partial last line removed by OpenFileSink, or nil if
+// the log ended with a complete record
+func (s *FileSink) Truncated() []byte {
+	return s.truncated
+}
+
+// lastRecord returns the last record of the log, or nil if it is empty, the
+// offset where its complete lines end and the partial line after them
+func lastRecord(r io.Reader) (last *record, end int64, partial []byte, err error) {
+	reader := bufio.NewReaderSize(r, 64*1024)
+	for lineNo := 1; ; lineNo++ {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return last, end, line, nil
+		}
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		end += int64(len(line))
+
+		data := bytes.TrimSpace(line)
+		if len(data) == 0 {
+			continue
+		}
+		var rec record
+		if err := json.Unmarshal(data, &rec); err != nil {
+			return nil, 0, nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		last = &rec
+	}
+}
+
+// Record implements Sink. The record is synced to disk before Record returns.
+func (s *FileSink) Record(ctx context.Context, entry Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return os.ErrClosed
+	}
+
+	rec := record{
+		Seq:      s.seq + 1,
+		Entry:    entry,
+		PrevHash: s.prevHash,
+	}
+	hash, err := rec.hash(s.opts)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+	rec.Hash = hash
+
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync audit log: %w", err)
+	}
+
+	s.seq = rec.Seq
+	s.prevHash = rec.Hash
+	return nil
+}
+
+// Close closes the audit log
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// Verify checks the hash chain of an audit log and returns the number of
+// records read. Errors for a broken chain match ErrTampered. Pass the key the
+// log was written with, if any, with WithKey.
+func Verify(r io.Reader, opts ...FileOption) (int, error) {
+	o := newFileOptions(opts)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	var (
+		count    int
+		prevHash string
+	)
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var rec record
+		if err := json.Unmarshal(data, &rec); err != nil {
+			return count, fmt.Errorf("%w: line %d: %v", ErrTampered, line, err)
+		}
+		if rec.Seq != uint64(count+1) {
+			return count, fmt.Errorf("%w: line %d: expected seq %d, got %d", ErrTampered, line, count+1, rec.Seq)
+		}
+		if rec.PrevHash != prevHash {
+			return count, fmt.Errorf("%w: line %d: previous hash mismatch", ErrTampered, line)
+		}
+		hash, err := rec.hash(o)
+		if err != nil {
+			return count, err
+		}
+		if hash != rec.Hash {
+			return count, fmt.Errorf("%w: line %d: hash mismatch", ErrTampered, line)
+		}
+
+		prevHash = rec.Hash
+		count++
+	}
+	return count, scanner.Err()
+}
+
+// VerifyFile checks the hash chain of the audit log at path
+func VerifyFile(path string, opts ...FileOption) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+	return Verify(file, opts...)
+}
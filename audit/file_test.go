@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeEntries(t *testing.T, path string, n int, opts ...FileOption) *FileSink {
+	t.Helper()
+	sink, err := OpenFileSink(path, opts...)
+	if err != nil {
+		t.Fatalf("OpenFileSink: %v", err)
+	}
+	for i := 0; i < n; i++ {
+		entry := Entry{Timestamp: time.Unix(int64(i), 0).UTC(), Operation: "abdm.profile.GetProfile", Outcome: OutcomeSuccess}
+		if err := sink.Record(context.Background(), entry); err != nil {
+			t.Fatalf("Record: %v", err)
+		}
+	}
+	return sink
+}
+
+func TestKeyedChainDetectsRecomputedHashes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	key := []byte("secret")
+	if err := writeEntries(t, path, 3, WithKey(key)).Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if n, err := VerifyFile(path, WithKey(key)); err != nil || n != 3 {
+		t.Fatalf("VerifyFile = %d, %v; want 3, nil", n, err)
+	}
+	if _, err := VerifyFile(path, WithKey([]byte("other"))); !errors.Is(err, ErrTampered) {
+		t.Fatalf("VerifyFile with wrong key: err = %v, want ErrTampered", err)
+	}
+
+	// An attacker without the key rewrites the log with a valid unkeyed chain
+	forged := filepath.Join(t.TempDir(), "forged.jsonl")
+	if err := writeEntries(t, forged, 3).Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifyFile(forged); err != nil {
+		t.Fatalf("unkeyed chain: %v", err)
+	}
+	if _, err := VerifyFile(forged, WithKey(key)); !errors.Is(err, ErrTampered) {
+		t.Fatalf("forged log: err = %v, want ErrTampered", err)
+	}
+}
+
+func TestOpenFileSinkTruncatesPartialLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	if err := writeEntries(t, path, 2).Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a crash in the middle of writing the third record
+	partial := []byte(`{"seq":3,"timestamp":"2026-01-`)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write(partial); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	sink := writeEntries(t, path, 1)
+	if !bytes.Equal(sink.Truncated(), partial) {
+		t.Errorf("Truncated = %q, want %q", sink.Truncated(), partial)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := VerifyFile(path); err != nil || n != 3 {
+		t.Fatalf("VerifyFile = %d, %v; want 3, nil", n, err)
+	}
+}
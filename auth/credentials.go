@@ -2,6 +2,8 @@ package auth
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 )
@@ -13,13 +15,29 @@ type CredentialsProvider interface {
 	Retrieve(ctx context.Context) (*Credentials, error)
 }
 
+// CredentialsClearer is implemented by providers that cache or persist
+// credentials and can discard them
+type CredentialsClearer interface {
+	// Clear discards any cached or persisted credentials so that they are
+	// never returned again.
+	Clear()
+}
+
+// CredentialsRevoker is implemented by providers that can revoke the
+// credentials they issued on the server side
+type CredentialsRevoker interface {
+	// Revoke invalidates the current credentials with the auth server and
+	// clears them locally.
+	Revoke(ctx context.Context) error
+}
+
 // Credentials represents the authentication credentials for API access
 type Credentials struct {
 	// AccessToken is the JWT token for API authentication
-	AccessToken string
+	AccessToken Secret
 
 	// RefreshToken can be used to refresh the access token
-	RefreshToken string
+	RefreshToken Secret
 
 	// ExpiresAt is when the access token expires
 	ExpiresAt time.Time
@@ -31,6 +49,26 @@ type Credentials struct {
 	Source string
 }
 
+// String returns a representation of the credentials with the tokens redacted
+func (c Credentials) String() string {
+	return fmt.Sprintf("Credentials{AccessToken: %s, RefreshToken: %s, ExpiresAt: %s, RefreshExpiresAt: %s, Source: %s}",
+		c.AccessToken, c.RefreshToken, c.ExpiresAt, c.RefreshExpiresAt, c.Source)
+}
+
+// Format implements fmt.Formatter with redaction
+func (c Credentials) Format(f fmt.State, verb rune) { formatRedacted(f, verb, c.String()) }
+
+// LogValue implements slog.LogValuer with redaction
+func (c Credentials) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("access_token", c.AccessToken),
+		slog.Any("refresh_token", c.RefreshToken),
+		slog.Time("expires_at", c.ExpiresAt),
+		slog.Time("refresh_expires_at", c.RefreshExpiresAt),
+		slog.String("source", c.Source),
+	)
+}
+
 // Expired returns true if the credentials are expired
 func (c *Credentials) Expired() bool {
 	return time.Now().After(c.ExpiresAt.Add(-5 * time.Minute)) // 5 minute buffer
@@ -47,7 +85,7 @@ type StaticCredentialsProvider struct {
 }
 
 // NewStaticCredentialsProvider creates a new static credentials provider
-func NewStaticCredentialsProvider(accessToken, refreshToken string, expiresIn, refreshExpiresIn int) *StaticCredentialsProvider {
+func NewStaticCredentialsProvider(accessToken, refreshToken Secret, expiresIn, refreshExpiresIn int) *StaticCredentialsProvider {
 	now := time.Now()
 	return &StaticCredentialsProvider{
 		credentials: &Credentials{
@@ -67,18 +105,36 @@ func (p *StaticCredentialsProvider) Retrieve(ctx context.Context) (*Credentials,
 
 // ClientCredentialsProvider handles client-based authentication
 type ClientCredentialsProvider struct {
-	client  *Service
-	request *ClientLoginRequest
-	cache   *Credentials
-	mu      sync.RWMutex
+	client          AuthAPI
+	request         *ClientLoginRequest
+	cache           *Credentials
+	mu              sync.RWMutex
+	onRefreshed     func(ctx context.Context, expiresAt time.Time)
+	onRefreshFailed func(ctx context.Context, err error)
+}
+
+// ProviderOption configures a ClientCredentialsProvider
+type ProviderOption func(*ClientCredentialsProvider)
+
+// WithRefreshCallbacks reports token refreshes to onRefreshed and failed
+// refreshes to onRefreshFailed; either may be nil
+func WithRefreshCallbacks(onRefreshed func(ctx context.Context, expiresAt time.Time), onRefreshFailed func(ctx context.Context, err error)) ProviderOption {
+	return func(p *ClientCredentialsProvider) {
+		p.onRefreshed = onRefreshed
+		p.onRefreshFailed = onRefreshFailed
+	}
 }
 
 // NewClientCredentialsProvider creates a new client credentials provider
-func NewClientCredentialsProvider(client *Service, req *ClientLoginRequest) *ClientCredentialsProvider {
-	return &ClientCredentialsProvider{
+func NewClientCredentialsProvider(client AuthAPI, req *ClientLoginRequest, opts ...ProviderOption) *ClientCredentialsProvider {
+	p := &ClientCredentialsProvider{
 		client:  client,
 		request: req,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // Retrieve gets credentials using client authentication, with automatic refresh
@@ -115,8 +171,14 @@ func (p *ClientCredentialsProvider) Retrieve(ctx context.Context) (*Credentials,
 				RefreshExpiresAt: time.Now().Add(time.Duration(resp.RefreshExpiresIn) * time.Second),
 				Source:           "ClientCredentialsProvider(refresh)",
 			}
+			if p.onRefreshed != nil {
+				p.onRefreshed(ctx, p.cache.ExpiresAt)
+			}
 			return p.cache, nil
 		}
+		if p.onRefreshFailed != nil {
+			p.onRefreshFailed(ctx, err)
+		}
 		// If refresh fails, fall through to login
 	}
 
@@ -137,6 +199,32 @@ func (p *ClientCredentialsProvider) Retrieve(ctx context.Context) (*Credentials,
 	return p.cache, nil
 }
 
+// Clear discards the cached credentials; the next Retrieve performs a fresh login
+func (p *ClientCredentialsProvider) Clear() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cache = nil
+}
+
+// Revoke logs out the cached session with the auth server and clears the cache.
+// It is a no-op if no credentials have been retrieved yet.
+func (p *ClientCredentialsProvider) Revoke(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.cache == nil {
+		return nil
+	}
+
+	creds := p.cache
+	p.cache = nil
+
+	return p.client.Logout(ctx, &LogoutRequest{
+		AccessToken:  creds.AccessToken,
+		RefreshToken: creds.RefreshToken,
+	})
+}
+
 // CredentialsCache wraps a credentials provider with caching capabilities
 type CredentialsCache struct {
 	provider CredentialsProvider
@@ -177,3 +265,31 @@ func (c *CredentialsCache) Retrieve(ctx context.Context) (*Credentials, error) {
 	c.cache = creds
 	return creds, nil
 }
+
+// Clear discards the cached credentials and clears the underlying provider
+// if it supports it
+func (c *CredentialsCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cache = nil
+	if clearer, ok := c.provider.(CredentialsClearer); ok {
+		clearer.Clear()
+	}
+}
+
+// Revoke revokes the credentials through the underlying provider, if it
+// supports revocation, and clears the cache
+func (c *CredentialsCache) Revoke(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cache = nil
+	if revoker, ok := c.provider.(CredentialsRevoker); ok {
+		return revoker.Revoke(ctx)
+	}
+	if clearer, ok := c.provider.(CredentialsClearer); ok {
+		clearer.Clear()
+	}
+	return nil
+}
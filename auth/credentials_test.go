@@ -0,0 +1,60 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/eka-care/eka-sdk-go/auth"
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+	"github.com/eka-care/eka-sdk-go/mocks"
+)
+
+func TestClientCredentialsProviderReportsRefreshes(t *testing.T) {
+	refreshErr := errors.New("refresh rejected")
+	var failRefresh bool
+	api := &mocks.AuthAPIMock{
+		// Access tokens expire immediately so every Retrieve refreshes
+		ClientLoginFunc: func(ctx context.Context, req *auth.ClientLoginRequest, opts ...interfaces.RequestOption) (*auth.ClientLoginResponse, error) {
+			return &auth.ClientLoginResponse{AccessToken: "login", RefreshToken: "refresh", RefreshExpiresIn: 3600}, nil
+		},
+		RefreshTokenFunc: func(ctx context.Context, req *auth.RefreshTokenRequest, opts ...interfaces.RequestOption) (*auth.RefreshTokenResponse, error) {
+			if failRefresh {
+				return nil, refreshErr
+			}
+			return &auth.RefreshTokenResponse{AccessToken: "refreshed", RefreshToken: "refresh", RefreshExpiresIn: 3600}, nil
+		},
+	}
+
+	var refreshed int
+	var failures []error
+	p := auth.NewClientCredentialsProvider(api, &auth.ClientLoginRequest{}, auth.WithRefreshCallbacks(
+		func(ctx context.Context, expiresAt time.Time) { refreshed++ },
+		func(ctx context.Context, err error) { failures = append(failures, err) },
+	))
+	ctx := context.Background()
+
+	if _, err := p.Retrieve(ctx); err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if refreshed != 0 || len(failures) != 0 {
+		t.Fatalf("login reported as refresh: %d, %v", refreshed, failures)
+	}
+
+	creds, err := p.Retrieve(ctx)
+	if err != nil || creds.AccessToken != "refreshed" {
+		t.Fatalf("refresh = %v, %v", creds, err)
+	}
+	if refreshed != 1 {
+		t.Errorf("refreshed = %d, want 1", refreshed)
+	}
+
+	failRefresh = true
+	if _, err := p.Retrieve(ctx); err != nil {
+		t.Fatalf("re-login after failed refresh: %v", err)
+	}
+	if len(failures) != 1 || !errors.Is(failures[0], refreshErr) {
+		t.Errorf("failures = %v, want [%v]", failures, refreshErr)
+	}
+}
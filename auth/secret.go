@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+// redacted is printed in place of secret values
+const redacted = "[REDACTED]"
+
+// Secret holds a sensitive string such as a client secret or a token.
+//
+// Secret is redacted whenever it is printed with the fmt package or logged
+// with log/slog, but it marshals to JSON as the plain value so request and
+// response payloads keep their wire format. Use Reveal to obtain the raw value.
+type Secret string
+
+// Reveal returns the raw secret value
+func (s Secret) Reveal() string {
+	return string(s)
+}
+
+// IsZero returns true if the secret is empty
+func (s Secret) IsZero() bool {
+	return s == ""
+}
+
+// Equal compares two secrets in constant time without allocating
+func (s Secret) Equal(other Secret) bool {
+	if len(s) != len(other) {
+		return false
+	}
+
+	var diff byte
+	for i := 0; i < len(s); i++ {
+		diff |= s[i] ^ other[i]
+	}
+	return diff == 0
+}
+
+// String returns a redacted representation of the secret. An empty secret is
+// rendered as an empty string so that missing values remain visible.
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+// Format implements fmt.Formatter so that no verb can print the raw value
+func (s Secret) Format(f fmt.State, verb rune) {
+	formatRedacted(f, verb, s.String())
+}
+
+// LogValue implements slog.LogValuer
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+// formatRedacted writes an already-redacted representation for any fmt verb
+func formatRedacted(f fmt.State, verb rune, s string) {
+	if verb == 'q' {
+		s = fmt.Sprintf("%q", s)
+	}
+	_, _ = io.WriteString(f, s)
+}
@@ -3,20 +3,33 @@ package auth
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/eka-care/eka-sdk-go/internal/http"
 	"github.com/eka-care/eka-sdk-go/internal/interfaces"
 )
 
+//go:generate moq -rm -pkg mocks -out ../mocks/auth.go . AuthAPI
+
+// AuthAPI is the authentication service, implemented by *Service
+type AuthAPI interface {
+	ClientLogin(ctx context.Context, req *ClientLoginRequest, opts ...interfaces.RequestOption) (*ClientLoginResponse, error)
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...interfaces.RequestOption) (*RefreshTokenResponse, error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...interfaces.RequestOption) error
+	Revoke(ctx context.Context, req *RevokeTokenRequest, opts ...interfaces.RequestOption) error
+}
+
+// Ensure Service implements AuthAPI
+var _ AuthAPI = (*Service)(nil)
+
 // Service handles authentication operations for the Eka developer platform
 type Service struct {
 	config interfaces.Config
 	http   *http.Client
 }
 
-// NewService creates a new authentication service instance
-func NewService(config interfaces.Config) *Service {
-	httpClient := http.NewClientFromInterface(config)
+// NewService creates a new authentication service instance sharing the given HTTP client
+func NewService(config interfaces.Config, httpClient *http.Client) *Service {
 	return &Service{
 		config: config,
 		http:   httpClient,
@@ -24,11 +37,13 @@ func NewService(config interfaces.Config) *Service {
 }
 
 // ClientLogin performs client authentication to get access and refresh tokens
-func (s *Service) ClientLogin(ctx context.Context, req *ClientLoginRequest) (*ClientLoginResponse, error) {
+func (s *Service) ClientLogin(ctx context.Context, req *ClientLoginRequest, opts ...interfaces.RequestOption) (*ClientLoginResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method: "POST",
-		Path:   "/connect-auth/v1/account/login",
-		Body:   req,
+		Operation: "auth.ClientLogin",
+		Method:    "POST",
+		Path:      "/connect-auth/v1/account/login",
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("client login request failed: %w", err)
@@ -43,11 +58,13 @@ func (s *Service) ClientLogin(ctx context.Context, req *ClientLoginRequest) (*Cl
 }
 
 // RefreshToken refreshes the access token using a refresh token
-func (s *Service) RefreshToken(ctx context.Context, req *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+func (s *Service) RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...interfaces.RequestOption) (*RefreshTokenResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method: "POST",
-		Path:   "/connect-auth/v1/account/refresh",
-		Body:   req,
+		Operation: "auth.RefreshToken",
+		Method:    "POST",
+		Path:      "/connect-auth/v1/account/refresh",
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("token refresh request failed: %w", err)
@@ -60,3 +77,64 @@ func (s *Service) RefreshToken(ctx context.Context, req *RefreshTokenRequest) (*
 
 	return &response, nil
 }
+
+// Logout ends the session identified by the given tokens, invalidating both
+// the access token and the refresh token
+func (s *Service) Logout(ctx context.Context, req *LogoutRequest, opts ...interfaces.RequestOption) error {
+	_, err := s.http.Do(ctx, &interfaces.HTTPRequest{
+		Operation: "auth.Logout",
+		Method:    "POST",
+		Path:      "/connect-auth/v1/account/logout",
+		Body:      req,
+		Options:   opts,
+	})
+	if err != nil {
+		return fmt.Errorf("logout request failed: %w", err)
+	}
+
+	return nil
+}
+
+// Revoke revokes a single access or refresh token, e.g. after a secret leak
+func (s *Service) Revoke(ctx context.Context, req *RevokeTokenRequest, opts ...interfaces.RequestOption) error {
+	_, err := s.http.Do(ctx, &interfaces.HTTPRequest{
+		Operation: "auth.Revoke",
+		Method:    "POST",
+		Path:      "/connect-auth/v1/account/revoke",
+		Body:      req,
+		Options:   opts,
+	})
+	if err != nil {
+		return fmt.Errorf("token revoke request failed: %w", err)
+	}
+
+	return nil
+}
+
+// RefreshReporting returns a ProviderOption that reports token refreshes to
+// the metrics collector and hooks configured for the service
+func (s *Service) RefreshReporting() ProviderOption {
+	return WithRefreshCallbacks(s.tokenRefreshed, s.tokenRefreshFailed)
+}
+
+// tokenRefreshed reports a successful token refresh to the metrics collector
+// and the OnTokenRefreshed hook
+func (s *Service) tokenRefreshed(ctx context.Context, expiresAt time.Time) {
+	if m, ok := s.config.GetMetricsCollector().(interfaces.TokenRefreshMetrics); ok {
+		m.RecordTokenRefresh(true)
+	}
+	if hooks := s.config.GetHooks(); hooks != nil && hooks.OnTokenRefreshed != nil {
+		hooks.OnTokenRefreshed(ctx, interfaces.TokenRefreshedEvent{ExpiresAt: expiresAt})
+	}
+}
+
+// tokenRefreshFailed reports a failed token refresh to the metrics collector
+// and the OnTokenRefreshFailed hook
+func (s *Service) tokenRefreshFailed(ctx context.Context, err error) {
+	if m, ok := s.config.GetMetricsCollector().(interfaces.TokenRefreshMetrics); ok {
+		m.RecordTokenRefresh(false)
+	}
+	if hooks := s.config.GetHooks(); hooks != nil && hooks.OnTokenRefreshFailed != nil {
+		hooks.OnTokenRefreshFailed(ctx, interfaces.TokenRefreshFailedEvent{Err: err})
+	}
+}
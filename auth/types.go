@@ -1,29 +1,126 @@
 package auth
 
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
 // ClientLoginRequest represents the request payload for client login
 type ClientLoginRequest struct {
 	ClientID     string `json:"client_id"`
-	ClientSecret string `json:"client_secret"`
+	ClientSecret Secret `json:"client_secret"`
+}
+
+// String returns a representation of the request with the secret redacted
+func (r ClientLoginRequest) String() string {
+	return fmt.Sprintf("ClientLoginRequest{ClientID: %s, ClientSecret: %s}", r.ClientID, r.ClientSecret)
+}
+
+// Format implements fmt.Formatter with redaction
+func (r ClientLoginRequest) Format(f fmt.State, verb rune) { formatRedacted(f, verb, r.String()) }
+
+// LogValue implements slog.LogValuer with redaction
+func (r ClientLoginRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("client_id", r.ClientID),
+		slog.Any("client_secret", r.ClientSecret),
+	)
 }
 
 // ClientLoginResponse represents the response from client login
 type ClientLoginResponse struct {
-	AccessToken      string `json:"access_token"`
-	ExpiresIn        int    `json:"expires_in"`
-	RefreshExpiresIn int    `json:"refresh_expires_in"`
-	RefreshToken     string `json:"refresh_token"`
+	AccessToken      Secret                     `json:"access_token"`
+	ExpiresIn        int                        `json:"expires_in"`
+	RefreshExpiresIn int                        `json:"refresh_expires_in"`
+	RefreshToken     Secret                     `json:"refresh_token"`
+	Extra            map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
+}
+
+// String returns a representation of the response with the tokens redacted
+func (r ClientLoginResponse) String() string {
+	return fmt.Sprintf("ClientLoginResponse{AccessToken: %s, ExpiresIn: %d, RefreshExpiresIn: %d, RefreshToken: %s}",
+		r.AccessToken, r.ExpiresIn, r.RefreshExpiresIn, r.RefreshToken)
+}
+
+// Format implements fmt.Formatter with redaction
+func (r ClientLoginResponse) Format(f fmt.State, verb rune) { formatRedacted(f, verb, r.String()) }
+
+// LogValue implements slog.LogValuer with redaction
+func (r ClientLoginResponse) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("access_token", r.AccessToken),
+		slog.Int("expires_in", r.ExpiresIn),
+		slog.Int("refresh_expires_in", r.RefreshExpiresIn),
+		slog.Any("refresh_token", r.RefreshToken),
+	)
 }
 
 // RefreshTokenRequest represents the request payload for token refresh
 type RefreshTokenRequest struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
+	AccessToken  Secret `json:"access_token"`
+	RefreshToken Secret `json:"refresh_token"`
+}
+
+// String returns a representation of the request with the tokens redacted
+func (r RefreshTokenRequest) String() string {
+	return fmt.Sprintf("RefreshTokenRequest{AccessToken: %s, RefreshToken: %s}", r.AccessToken, r.RefreshToken)
+}
+
+// Format implements fmt.Formatter with redaction
+func (r RefreshTokenRequest) Format(f fmt.State, verb rune) { formatRedacted(f, verb, r.String()) }
+
+// LogValue implements slog.LogValuer with redaction
+func (r RefreshTokenRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("access_token", r.AccessToken),
+		slog.Any("refresh_token", r.RefreshToken),
+	)
 }
 
 // RefreshTokenResponse represents the response from token refresh
 type RefreshTokenResponse struct {
-	AccessToken      string `json:"access_token"`
-	ExpiresIn        int    `json:"expires_in"`
-	RefreshExpiresIn int    `json:"refresh_expires_in"`
-	RefreshToken     string `json:"refresh_token"`
+	AccessToken      Secret                     `json:"access_token"`
+	ExpiresIn        int                        `json:"expires_in"`
+	RefreshExpiresIn int                        `json:"refresh_expires_in"`
+	RefreshToken     Secret                     `json:"refresh_token"`
+	Extra            map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
+
+// String returns a representation of the response with the tokens redacted
+func (r RefreshTokenResponse) String() string {
+	return fmt.Sprintf("RefreshTokenResponse{AccessToken: %s, ExpiresIn: %d, RefreshExpiresIn: %d, RefreshToken: %s}",
+		r.AccessToken, r.ExpiresIn, r.RefreshExpiresIn, r.RefreshToken)
+}
+
+// Format implements fmt.Formatter with redaction
+func (r RefreshTokenResponse) Format(f fmt.State, verb rune) { formatRedacted(f, verb, r.String()) }
+
+// LogValue implements slog.LogValuer with redaction
+func (r RefreshTokenResponse) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("access_token", r.AccessToken),
+		slog.Int("expires_in", r.ExpiresIn),
+		slog.Int("refresh_expires_in", r.RefreshExpiresIn),
+		slog.Any("refresh_token", r.RefreshToken),
+	)
+}
+
+// LogoutRequest represents the request payload for ending a client session
+type LogoutRequest struct {
+	AccessToken  Secret `json:"access_token"`
+	RefreshToken Secret `json:"refresh_token"`
+}
+
+// RevokeTokenRequest represents the request payload for revoking a single token
+type RevokeTokenRequest struct {
+	Token         Secret `json:"token"`
+	TokenTypeHint string `json:"token_type_hint,omitempty"` // access_token or refresh_token
+}
+
+const (
+	// TokenTypeAccessToken hints that the revoked token is an access token
+	TokenTypeAccessToken = "access_token"
+	// TokenTypeRefreshToken hints that the revoked token is a refresh token
+	TokenTypeRefreshToken = "refresh_token"
+)
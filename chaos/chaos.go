@@ -0,0 +1,199 @@
+// Package chaos injects faults into SDK requests to test retry, circuit
+// breaker and fallback paths against ABDM misbehaviour: latency spikes,
+// 5xx responses, connection resets, truncated or slow bodies and rate
+// limiting.
+//
+// Faults are chosen per attempt by rules matching the method and path, each
+// with a probability. A fixed seed makes a run reproducible:
+//
+//	injector, err := chaos.New([]chaos.Rule{
+//		{Path: "/abdm/na/v1/registration/aadhaar/*", Probability: 0.3, Fault: chaos.Status(http.StatusServiceUnavailable)},
+//		{Path: "/abdm/v1/profile", Probability: 0.1, Fault: chaos.Latency(5 * time.Second)},
+//		{Probability: 0.05, Fault: chaos.ConnectionReset()},
+//	}, chaos.WithSeed(42))
+//	if err != nil {
+//		return err
+//	}
+//	client := ekasdk.New(ekasdk.WithTransportMiddleware(injector.Middleware()))
+//
+// Installed with WithTransportMiddleware the injector sits below retries,
+// so injected failures are retried (see WithRetries), logged and counted by
+// the circuit breaker exactly like real ones. Installed with WithMiddleware
+// it sees each call once, after retries.
+package chaos
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"path"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// Rule injects Fault into a share of the attempts matching Method and Path
+type Rule struct {
+	// Method matches the request method; empty matches every method
+	Method string
+	// Path is a path.Match pattern, where * matches a single path segment,
+	// e.g. /abdm/na/v1/registration/aadhaar/*; empty matches every path
+	Path string
+	// Probability of injecting the fault into a matching attempt, from 0 to 1
+	Probability float64
+	// Fault is injected when the rule fires
+	Fault Fault
+}
+
+// matches reports whether the rule applies to req
+func (r *Rule) matches(req *http.Request) bool {
+	if r.Method != "" && r.Method != req.Method {
+		return false
+	}
+	if r.Path == "" {
+		return true
+	}
+	ok, _ := path.Match(r.Path, req.URL.Path)
+	return ok
+}
+
+// Option configures an Injector
+type Option func(*options)
+
+type options struct {
+	seed    uint64
+	seeded  bool
+	enabled bool
+}
+
+// WithSeed seeds the random number generator, so that the same sequence of
+// requests receives the same faults on every run
+func WithSeed(seed uint64) Option {
+	return func(o *options) {
+		o.seed = seed
+		o.seeded = true
+	}
+}
+
+// WithEnabled sets whether faults are injected initially; the default is true
+func WithEnabled(enabled bool) Option {
+	return func(o *options) {
+		o.enabled = enabled
+	}
+}
+
+// Injector is a middleware that injects faults according to its rules. It
+// is safe for concurrent use.
+type Injector struct {
+	rules   []Rule
+	enabled atomic.Bool
+
+	mu       sync.Mutex
+	rng      *rand.Rand
+	injected []int
+}
+
+// New creates an injector. For every attempt the rules are evaluated in
+// order and the first matching rule that fires injects its fault; attempts
+// no rule fires for pass through unchanged. Without WithSeed the generator
+// is seeded randomly.
+func New(rules []Rule, opts ...Option) (*Injector, error) {
+	o := &options{enabled: true}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	for i, rule := range rules {
+		if rule.Fault == nil {
+			return nil, fmt.Errorf("chaos rule %d: fault must not be nil", i)
+		}
+		if rule.Probability < 0 || rule.Probability > 1 {
+			return nil, fmt.Errorf("chaos rule %d: probability must be between 0 and 1, got %g", i, rule.Probability)
+		}
+		if _, err := path.Match(rule.Path, ""); err != nil {
+			return nil, fmt.Errorf("chaos rule %d: invalid path pattern %q: %w", i, rule.Path, err)
+		}
+	}
+
+	seed := o.seed
+	if !o.seeded {
+		seed = rand.Uint64()
+	}
+	i := &Injector{
+		rules:    append([]Rule(nil), rules...),
+		rng:      rand.New(rand.NewPCG(seed, seed)),
+		injected: make([]int, len(rules)),
+	}
+	i.enabled.Store(o.enabled)
+	return i, nil
+}
+
+// Middleware returns the middleware to install with
+// ekasdk.WithTransportMiddleware or ekasdk.WithMiddleware
+func (i *Injector) Middleware() interfaces.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &chaosTransport{next: next, injector: i}
+	}
+}
+
+// SetEnabled turns fault injection on or off
+func (i *Injector) SetEnabled(enabled bool) {
+	i.enabled.Store(enabled)
+}
+
+// Injected returns the number of faults injected by each rule, in rule order
+func (i *Injector) Injected() []int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return append([]int(nil), i.injected...)
+}
+
+// pick returns the fault to inject into req, or nil
+func (i *Injector) pick(req *http.Request) Fault {
+	if !i.enabled.Load() {
+		return nil
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for n := range i.rules {
+		rule := &i.rules[n]
+		if !rule.matches(req) {
+			continue
+		}
+		// A draw is taken for every matching rule, so that the sequence of
+		// faults depends only on the seed and the requests
+		if i.rng.Float64() < rule.Probability {
+			i.injected[n]++
+			return rule.Fault
+		}
+	}
+	return nil
+}
+
+// chaosTransport injects faults into requests
+type chaosTransport struct {
+	next     http.RoundTripper
+	injector *Injector
+}
+
+func (t *chaosTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if fault := t.injector.pick(req); fault != nil {
+		return fault(req, t.next)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// sleep waits for d or until req is cancelled
+func sleep(req *http.Request, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,206 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// backend answers every request with 200 and body
+func backend(body string) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})
+}
+
+// trackedBody records whether it was closed
+type trackedBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *trackedBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func newRequest(t testing.TB, method, path string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(method, "https://api.eka.care"+path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func TestNewValidatesRules(t *testing.T) {
+	for _, rule := range []Rule{
+		{Probability: 0.5},
+		{Probability: 1.5, Fault: ConnectionReset()},
+		{Probability: -0.1, Fault: ConnectionReset()},
+		{Path: "/abdm/[", Probability: 1, Fault: ConnectionReset()},
+	} {
+		if _, err := New([]Rule{rule}); err == nil {
+			t.Errorf("New(%+v) succeeded, want an error", rule)
+		}
+	}
+}
+
+func TestRuleMatching(t *testing.T) {
+	injector, err := New([]Rule{
+		{Method: http.MethodPost, Path: "/abdm/na/v1/registration/aadhaar/*", Probability: 1, Fault: Status(http.StatusBadGateway)},
+		{Path: "/abdm/v1/profile", Probability: 0, Fault: Status(http.StatusGatewayTimeout)},
+		{Path: "/abdm/v1/*", Probability: 1, Fault: Status(http.StatusServiceUnavailable)},
+	}, WithSeed(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := injector.Middleware()(backend("{}"))
+
+	for _, tt := range []struct {
+		method, path string
+		status       int
+	}{
+		{http.MethodPost, "/abdm/na/v1/registration/aadhaar/init", http.StatusBadGateway},
+		{http.MethodGet, "/abdm/na/v1/registration/aadhaar/init", http.StatusOK},
+		{http.MethodPost, "/abdm/na/v1/registration/aadhaar/otp/verify", http.StatusOK},
+		{http.MethodGet, "/abdm/v1/profile", http.StatusServiceUnavailable},
+		{http.MethodGet, "/connect-auth/v1/account/login", http.StatusOK},
+	} {
+		resp, err := rt.RoundTrip(newRequest(t, tt.method, tt.path))
+		if err != nil {
+			t.Fatalf("%s %s: %v", tt.method, tt.path, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tt.status {
+			t.Errorf("%s %s: status %d, want %d", tt.method, tt.path, resp.StatusCode, tt.status)
+		}
+	}
+	if got := injector.Injected(); !slices.Equal(got, []int{1, 0, 1}) {
+		t.Errorf("Injected = %v, want [1 0 1]", got)
+	}
+
+	injector.SetEnabled(false)
+	resp, err := rt.RoundTrip(newRequest(t, http.MethodGet, "/abdm/v1/profile"))
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Errorf("disabled injector: %v, %v", resp, err)
+	}
+}
+
+func TestSeededRunsAreReproducible(t *testing.T) {
+	run := func(seed uint64) []bool {
+		injector, err := New([]Rule{{Probability: 0.5, Fault: ConnectionReset()}}, WithSeed(seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+		rt := injector.Middleware()(backend("{}"))
+		var failed []bool
+		for i := 0; i < 64; i++ {
+			resp, err := rt.RoundTrip(newRequest(t, http.MethodGet, "/abdm/v1/profile"))
+			if err == nil {
+				resp.Body.Close()
+			}
+			failed = append(failed, err != nil)
+		}
+		return failed
+	}
+
+	first := run(42)
+	if !slices.Equal(first, run(42)) {
+		t.Error("two runs with the same seed injected different faults")
+	}
+	if slices.Equal(first, run(43)) {
+		t.Error("runs with different seeds injected the same faults")
+	}
+	if n := len(slices.DeleteFunc(slices.Clone(first), func(failed bool) bool { return !failed })); n == 0 || n == len(first) {
+		t.Errorf("%d of %d attempts failed, want some", n, len(first))
+	}
+}
+
+func TestShortCircuitFaultsCloseRequestBody(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for name, fault := range map[string]Fault{
+		"Status":          Status(http.StatusServiceUnavailable),
+		"TooManyRequests": TooManyRequests(1500 * time.Millisecond),
+		"ConnectionReset": ConnectionReset(),
+		"Latency":         Latency(time.Minute),
+	} {
+		body := &trackedBody{Reader: strings.NewReader(`{"txn_id":"t"}`)}
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.eka.care/abdm/v1/profile", body)
+		resp, err := fault(req, backend("{}"))
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if !body.closed {
+			t.Errorf("%s did not close the request body", name)
+		}
+		switch name {
+		case "TooManyRequests":
+			if resp.StatusCode != http.StatusTooManyRequests || resp.Header.Get("Retry-After") != "2" {
+				t.Errorf("TooManyRequests: status %d, Retry-After %q", resp.StatusCode, resp.Header.Get("Retry-After"))
+			}
+		case "ConnectionReset":
+			if !errors.Is(err, syscall.ECONNRESET) {
+				t.Errorf("ConnectionReset: err = %v", err)
+			}
+		case "Latency":
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Latency: err = %v, want the context error", err)
+			}
+		}
+	}
+}
+
+func TestTruncatedBody(t *testing.T) {
+	resp, err := TruncatedBody()(newRequest(t, http.MethodGet, "/abdm/v1/profile"), backend(`{"abha_address":"a@abdm"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != `{"abha_addre` || resp.ContentLength != int64(len(body)) {
+		t.Errorf("body = %q, ContentLength %d", body, resp.ContentLength)
+	}
+}
+
+func TestSlowBody(t *testing.T) {
+	const payload = "0123456789012345678901234567890123456789"
+	start := time.Now()
+	resp, err := SlowBody(400)(newRequest(t, http.MethodGet, "/abdm/v1/profile"), backend(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil || string(body) != payload {
+		t.Fatalf("body = %q, %v", body, err)
+	}
+	// 40 bytes at 400 bytes per second take 100ms
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("body read in %s, want at least 100ms", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.eka.care/abdm/v1/profile", nil)
+	resp, err = SlowBody(1)(req, backend(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	if _, err := io.ReadAll(resp.Body); !errors.Is(err, context.Canceled) {
+		t.Errorf("read after cancel: err = %v, want context.Canceled", err)
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,151 @@
+package chaos
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Fault handles an attempt in place of, or around, the next transport
+type Fault func(req *http.Request, next http.RoundTripper) (*http.Response, error)
+
+// Latency delays the attempt by d before sending it
+func Latency(d time.Duration) Fault {
+	return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		if err := sleep(req, d); err != nil {
+			closeBody(req)
+			return nil, err
+		}
+		return next.RoundTrip(req)
+	}
+}
+
+// Status answers the attempt with an error response with the given status,
+// e.g. 502, 503 or 504, without sending it
+func Status(code int) Fault {
+	return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		closeBody(req)
+		return errorResponse(req, code), nil
+	}
+}
+
+// TooManyRequests answers the attempt with 429 Too Many Requests and a
+// Retry-After header of retryAfter, rounded up to whole seconds, without
+// sending it
+func TooManyRequests(retryAfter time.Duration) Fault {
+	seconds := int((retryAfter + time.Second - 1) / time.Second)
+	return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		closeBody(req)
+		resp := errorResponse(req, http.StatusTooManyRequests)
+		resp.Header.Set("Retry-After", strconv.Itoa(seconds))
+		return resp, nil
+	}
+}
+
+// ConnectionReset fails the attempt with a connection reset error without
+// sending it
+func ConnectionReset() Fault {
+	return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		closeBody(req)
+		return nil, &net.OpError{
+			Op:  "read",
+			Net: "tcp",
+			Err: os.NewSyscallError("read", syscall.ECONNRESET),
+		}
+	}
+}
+
+// TruncatedBody sends the attempt and cuts the response body in half, as when
+// a proxy drops the connection mid-response, so JSON bodies fail to decode
+func TruncatedBody() Fault {
+	return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		resp, err := next.RoundTrip(req)
+		if err != nil {
+			return resp, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = body[:len(body)/2]
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		resp.ContentLength = int64(len(body))
+		resp.Header.Del("Content-Length")
+		return resp, nil
+	}
+}
+
+// SlowBody sends the attempt and delivers the response body at
+// bytesPerSecond, so that reads are slow but eventually complete
+func SlowBody(bytesPerSecond int) Fault {
+	if bytesPerSecond < 1 {
+		bytesPerSecond = 1
+	}
+	return func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		resp, err := next.RoundTrip(req)
+		if err != nil {
+			return resp, err
+		}
+		resp.Body = &slowReader{body: resp.Body, req: req, bytesPerSecond: bytesPerSecond}
+		return resp, nil
+	}
+}
+
+// slowReader throttles reads to bytesPerSecond, delivering about ten chunks
+// per second
+type slowReader struct {
+	body           io.ReadCloser
+	req            *http.Request
+	bytesPerSecond int
+}
+
+func (r *slowReader) Read(p []byte) (int, error) {
+	chunk := max(r.bytesPerSecond/10, 1)
+	if len(p) > chunk {
+		p = p[:chunk]
+	}
+	n, err := r.body.Read(p)
+	if n > 0 {
+		if sleepErr := sleep(r.req, time.Duration(n)*time.Second/time.Duration(r.bytesPerSecond)); sleepErr != nil {
+			return n, sleepErr
+		}
+	}
+	return n, err
+}
+
+func (r *slowReader) Close() error {
+	return r.body.Close()
+}
+
+// closeBody closes the body of a request that is answered without sending
+// it, as the http.RoundTripper contract requires
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+// errorResponse returns a gateway style JSON error response for req
+func errorResponse(req *http.Request, code int) *http.Response {
+	body := fmt.Sprintf(`{"code":%d,"error":%q}`, code, "chaos: injected "+http.StatusText(code))
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		StatusCode:    code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader([]byte(body))),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,56 @@
+package ekasdk
+
+import (
+	"time"
+
+	"github.com/eka-care/eka-sdk-go/internal/errors"
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// CircuitState is the state of a circuit breaker
+type CircuitState = interfaces.CircuitState
+
+// Circuit breaker states
+const (
+	CircuitClosed   = interfaces.CircuitClosed
+	CircuitOpen     = interfaces.CircuitOpen
+	CircuitHalfOpen = interfaces.CircuitHalfOpen
+)
+
+// CircuitBreakerConfig configures the circuit breaker kept per host and endpoint group
+type CircuitBreakerConfig = interfaces.CircuitBreakerConfig
+
+// ErrCircuitOpen is matched, using errors.Is, by errors returned without
+// sending the request because its circuit is open
+var ErrCircuitOpen = errors.ErrCircuitOpen
+
+// CircuitOpenError carries the circuit key and the time until the next probe
+type CircuitOpenError = errors.CircuitOpenError
+
+// DefaultCircuitBreakerConfig returns the default circuit breaker configuration
+func DefaultCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		FailureThreshold:    5,
+		OpenTimeout:         30 * time.Second,
+		HalfOpenMaxRequests: 1,
+	}
+}
+
+// WithCircuitBreaker enables a circuit breaker per host and endpoint group.
+// After FailureThreshold consecutive 5xx responses or timeouts the circuit
+// opens and calls fail immediately with ErrCircuitOpen; after OpenTimeout a
+// probe request decides whether it closes again. Calls cancelled by the
+// caller or cut short by a deadline of the caller's context do not count as
+// failures; the client's own Timeout does.
+func WithCircuitBreaker(config CircuitBreakerConfig) Option {
+	return func(opts *ClientOptions) {
+		opts.CircuitBreaker = &config
+	}
+}
+
+// CircuitBreakerStates returns the state of every circuit keyed by host and
+// endpoint group, for use in health checks. It returns nil if the circuit
+// breaker is disabled.
+func (c *Client) CircuitBreakerStates() map[string]CircuitState {
+	return c.http.CircuitStates()
+}
@@ -9,7 +9,10 @@
 //	// export EKA_CLIENT_ID=your-client-id
 //	// export EKA_CLIENT_SECRET=your-client-secret
 //
-//	client := ekasdk.NewFromEnv()
+//	client, err := ekasdk.NewClientFromEnv()
+//	if err != nil {
+//		log.Fatal(err) // lists every missing or invalid setting
+//	}
 //	if err := client.Login(ctx); err != nil {
 //		log.Fatal(err)
 //	}
@@ -38,14 +41,23 @@ package ekasdk
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
+	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/eka-care/eka-sdk-go/auth"
 	"github.com/eka-care/eka-sdk-go/internal/config"
+	"github.com/eka-care/eka-sdk-go/internal/errors"
+	ekahttp "github.com/eka-care/eka-sdk-go/internal/http"
 	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+	"github.com/eka-care/eka-sdk-go/internal/logging"
 	"github.com/eka-care/eka-sdk-go/services/abdm"
 )
 
@@ -59,16 +71,50 @@ const (
 	EnvironmentDevelopment Environment = "development"
 )
 
+// ErrClientClosed is returned by a client, and by every service obtained from
+// it, once Close has been called
+var ErrClientClosed = errors.ErrClientClosed
+
+// ErrUnknownFields is matched by the errors returned for responses with
+// unknown fields when strict decoding is enabled, see WithStrictDecoding
+var ErrUnknownFields = errors.ErrUnknownFields
+
+// UnknownFieldsError lists the unknown fields of a response in strict decoding mode
+type UnknownFieldsError = errors.UnknownFieldsError
+
 // Client represents the main Eka SDK client
 type Client struct {
-	config              interfaces.Config
-	credentialsProvider auth.CredentialsProvider
+	config  interfaces.Config
+	options *ClientOptions // resolved options, the base of derived clients
+	state   *authState     // shared with derived clients
+	http    *ekahttp.Client
 
 	// Service clients
 	Auth *auth.Service
 	ABDM *abdm.Client
 }
 
+// authState is the authentication state shared by a client and the clients
+// derived from it. mu serialises Login, Close and provider changes; the
+// access token itself lives in the config session and is read lock-free.
+type authState struct {
+	mu       sync.Mutex
+	provider auth.CredentialsProvider
+	// login is the provider created by the first Login and reused by later ones
+	login *auth.ClientCredentialsProvider
+}
+
+// currentProvider returns the credentials provider in use
+func (s *authState) currentProvider() auth.CredentialsProvider {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.provider
+}
+
+// Middleware wraps the transport of the client, e.g. to add headers or
+// record requests
+type Middleware = interfaces.Middleware
+
 // Option represents a configuration option for the client
 type Option func(*ClientOptions)
 
@@ -90,6 +136,62 @@ type ClientOptions struct {
 	RequestTimeout      time.Duration
 	ResponseTimeout     time.Duration
 	ConnectionTimeout   time.Duration
+
+	// StrictPatientContext rejects ABDM calls that carry no patient headers
+	StrictPatientContext bool
+	// StrictDecoding rejects responses with fields unknown to the SDK
+	StrictDecoding bool
+	// EnableRetries retries failed attempts up to MaxRetries times; retries
+	// are off by default
+	EnableRetries bool
+
+	// RateLimits configures client-side rate limiting per endpoint group
+	RateLimits map[EndpointGroup]RateLimit
+
+	// CircuitBreaker enables the circuit breaker when non-nil
+	CircuitBreaker *CircuitBreakerConfig
+
+	// Logger receives structured request logs. When nil and LogLevel is set,
+	// a text logger writing to stderr at LogLevel is used; logging is off
+	// when neither is set.
+	Logger *slog.Logger
+	// LogBodies logs masked request and response bodies at debug level
+	LogBodies bool
+
+	// TracerProvider enables OpenTelemetry tracing when non-nil
+	TracerProvider trace.TracerProvider
+
+	// Metrics receives request, retry, token refresh, OTP and error metrics when non-nil
+	Metrics MetricsCollector
+
+	// Hooks are called synchronously on SDK events when non-nil
+	Hooks *Hooks
+
+	// AuditSink receives an audit entry for every ABDM call when non-nil
+	AuditSink AuditSink
+
+	// BaseURL overrides the API base URL of the environment when non-empty
+	BaseURL string
+	// DefaultHeaders fill the patient headers a call leaves empty
+	DefaultHeaders Headers
+	// Header is sent with every request; per-request headers take precedence
+	Header http.Header
+	// Middleware wraps the SDK's transport chain, the last one outermost
+	Middleware []Middleware
+	// TransportMiddleware wraps every HTTP attempt below retries, logging and
+	// the circuit breaker, the last one outermost
+	TransportMiddleware []Middleware
+}
+
+// clone returns a copy of the options that can be modified without
+// affecting o
+func (o *ClientOptions) clone() *ClientOptions {
+	c := *o
+	c.RateLimits = maps.Clone(o.RateLimits)
+	c.Header = o.Header.Clone()
+	c.Middleware = slices.Clone(o.Middleware)
+	c.TransportMiddleware = slices.Clone(o.TransportMiddleware)
+	return &c
 }
 
 // DefaultClientOptions returns the default client options
@@ -99,7 +201,7 @@ func DefaultClientOptions() *ClientOptions {
 		Timeout:           30 * time.Second,
 		MaxRetries:        3,
 		UserAgent:         "eka-sdk-go/1.0.0",
-		LogLevel:          "info",
+		LogLevel:          "",
 		HTTPClient:        &http.Client{},
 		DisableSSL:        false,
 		Region:            "us",
@@ -153,6 +255,16 @@ func WithMaxRetries(maxRetries int) Option {
 	}
 }
 
+// WithRetries enables retrying attempts that fail with a network error or a
+// 5xx status, up to MaxRetries times. Only idempotent requests, or writes
+// sent with request.WithIdempotencyKey, are retried. Calls are not retried
+// by default.
+func WithRetries(enabled bool) Option {
+	return func(opts *ClientOptions) {
+		opts.EnableRetries = enabled
+	}
+}
+
 // WithUserAgent sets the user agent
 func WithUserAgent(userAgent string) Option {
 	return func(opts *ClientOptions) {
@@ -160,13 +272,51 @@ func WithUserAgent(userAgent string) Option {
 	}
 }
 
-// WithLogLevel sets the log level
+// WithLogLevel enables the built-in logger, writing to stderr at the given
+// level: "debug", "info", "warn" or "error"; "off" disables it
 func WithLogLevel(logLevel string) Option {
 	return func(opts *ClientOptions) {
 		opts.LogLevel = logLevel
 	}
 }
 
+// WithLogger sets the structured logger. Request logs carry the method, path,
+// status, latency, attempt and request ID; Aadhaar numbers, mobile numbers,
+// OTPs, tokens and the Authorization header are always masked.
+func WithLogger(logger *slog.Logger) Option {
+	return func(opts *ClientOptions) {
+		opts.Logger = logger
+	}
+}
+
+// WithLogBodies logs masked request and response bodies at debug level
+func WithLogBodies(logBodies bool) Option {
+	return func(opts *ClientOptions) {
+		opts.LogBodies = logBodies
+	}
+}
+
+// WithTracerProvider enables OpenTelemetry tracing. Every service operation
+// gets a span named after it, e.g. abdm.registration.AadhaarVerify, with a
+// client span per HTTP attempt, and the W3C traceparent header is sent on
+// every request. Span attributes never contain personal data.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(opts *ClientOptions) {
+		opts.TracerProvider = provider
+	}
+}
+
+// WithMetricsCollector installs a metrics collector, such as the Prometheus
+// collector from the metrics package. Requests are recorded once per call,
+// after retries; collectors implementing the optional interfaces in this
+// package also receive retries, token refreshes, OTP sends, API error codes
+// and circuit breaker state changes.
+func WithMetricsCollector(collector MetricsCollector) Option {
+	return func(opts *ClientOptions) {
+		opts.Metrics = collector
+	}
+}
+
 // WithHTTPClient sets the HTTP client
 func WithHTTPClient(httpClient *http.Client) Option {
 	return func(opts *ClientOptions) {
@@ -174,6 +324,44 @@ func WithHTTPClient(httpClient *http.Client) Option {
 	}
 }
 
+// WithBaseURL sends every call to baseURL instead of the environment's API
+// base URL, e.g. a regional gateway or a mock server
+func WithBaseURL(baseURL string) Option {
+	return func(opts *ClientOptions) {
+		opts.BaseURL = baseURL
+	}
+}
+
+// WithHeader adds a header sent with every request. Headers set with
+// request.WithHeader take precedence. Authorization, Content-Type and
+// User-Agent are always set by the SDK and cannot be overridden here.
+func WithHeader(key, value string) Option {
+	return func(opts *ClientOptions) {
+		if opts.Header == nil {
+			opts.Header = make(http.Header)
+		}
+		opts.Header.Add(key, value)
+	}
+}
+
+// WithMiddleware adds middleware around the SDK's transport chain. It sees
+// every call once, after retries; middleware added later runs outermost.
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(opts *ClientOptions) {
+		opts.Middleware = append(opts.Middleware, middleware...)
+	}
+}
+
+// WithTransportMiddleware adds middleware closest to the network. It sees
+// every attempt, so failures it returns are logged, retried and counted by
+// the circuit breaker like real network failures, e.g. when injecting faults
+// with the chaos package.
+func WithTransportMiddleware(middleware ...Middleware) Option {
+	return func(opts *ClientOptions) {
+		opts.TransportMiddleware = append(opts.TransportMiddleware, middleware...)
+	}
+}
+
 // WithDisableSSL sets whether to disable SSL verification
 func WithDisableSSL(disableSSL bool) Option {
 	return func(opts *ClientOptions) {
@@ -181,17 +369,69 @@ func WithDisableSSL(disableSSL bool) Option {
 	}
 }
 
+// WithStrictDecoding makes calls whose response carries fields unknown to
+// this SDK version fail with an UnknownFieldsError listing them. Use it in
+// development and tests to notice API additions; by default unknown fields
+// are kept in the Extra maps of the response types.
+func WithStrictDecoding(strict bool) Option {
+	return func(opts *ClientOptions) {
+		opts.StrictDecoding = strict
+	}
+}
+
+// WithStrictPatientContext makes ABDM calls fail with ErrMissingPatientContext
+// when no patient headers are passed explicitly or set with ContextWithPatient
+func WithStrictPatientContext(strict bool) Option {
+	return func(opts *ClientOptions) {
+		opts.StrictPatientContext = strict
+	}
+}
+
 // New creates a new Eka SDK client with the given options
 func New(opts ...Option) *Client {
 	options := DefaultClientOptions()
 	for _, opt := range opts {
 		opt(options)
 	}
+	return newClient(options, nil)
+}
+
+// With returns a client that applies opts on top of the options of c, for
+// example a shorter timeout or a different HIP ID for one code path:
+//
+//	hip := client.With(
+//		ekasdk.WithTimeout(5*time.Second),
+//		ekasdk.WithDefaultHeaders(ekasdk.Headers{HipID: "hip-2"}),
+//	)
+//
+// The derived client shares the credentials provider, access token, rate
+// limiter and circuit breaker of c, so it needs no login of its own and a
+// Login on either client applies to both. Credentials provider, rate limit
+// and circuit breaker options are therefore ignored. It also shares the
+// connection pool of c unless WithHTTPClient gives it a different HTTP
+// client.
+// Closing either client closes both. c and the derived client may be used
+// concurrently.
+func (c *Client) With(opts ...Option) *Client {
+	options := c.options.clone()
+	for _, opt := range opts {
+		opt(options)
+	}
+	return newClient(options, c)
+}
+
+// newClient creates a client from fully resolved options, sharing the
+// authentication state and transport of parent if it is not nil
+func newClient(options *ClientOptions, parent *Client) *Client {
+	baseURL := options.BaseURL
+	if baseURL == "" {
+		baseURL = getBaseURL(options.Environment)
+	}
 
 	// Create internal config manually
 	internalConfig := &config.Config{
 		Environment:       config.Environment(options.Environment),
-		BaseURL:           getBaseURL(options.Environment),
+		BaseURL:           baseURL,
 		ClientID:          options.ClientID,
 		ClientSecret:      options.ClientSecret,
 		Timeout:           options.Timeout,
@@ -206,21 +446,65 @@ func New(opts ...Option) *Client {
 		RequestTimeout:    options.RequestTimeout,
 		ResponseTimeout:   options.ResponseTimeout,
 		ConnectionTimeout: options.ConnectionTimeout,
+
+		StrictPatientContext: options.StrictPatientContext,
+		StrictDecoding:       options.StrictDecoding,
+		EnableRetries:        options.EnableRetries,
+		RateLimits:           options.RateLimits,
+		CircuitBreaker:       options.CircuitBreaker,
+		Logger:               options.Logger,
+		LogBodies:            options.LogBodies,
+		TracerProvider:       options.TracerProvider,
+		Metrics:              options.Metrics,
+		Hooks:                options.Hooks,
+		AuditSink:            options.AuditSink,
+		DefaultHeaders:       options.DefaultHeaders,
+		Header:               options.Header,
+		TransportMiddleware:  options.TransportMiddleware,
+	}
+	if internalConfig.Logger == nil {
+		internalConfig.Logger = logging.New(options.LogLevel)
+	}
+
+	// One HTTP client, and so one transport and connection pool, is shared
+	// by every service
+	var httpClient *ekahttp.Client
+	state := &authState{provider: options.CredentialsProvider}
+	if parent != nil {
+		internalConfig.Session = parent.config.(*config.Config).Session
+		httpClient = parent.http.Derive(internalConfig)
+		state = parent.state
+	} else {
+		internalConfig.Session = config.NewSession()
+		httpClient = ekahttp.NewClientFromInterface(internalConfig)
+	}
+	for _, mw := range options.Middleware {
+		httpClient.AddMiddleware(mw)
 	}
 
 	return &Client{
-		config:              internalConfig,
-		credentialsProvider: options.CredentialsProvider,
-		Auth:                auth.NewService(internalConfig),
-		ABDM:                createABDMClient(internalConfig),
+		config:  internalConfig,
+		options: options,
+		state:   state,
+		http:    httpClient,
+		Auth:    auth.NewService(internalConfig, httpClient),
+		ABDM:    createABDMClient(internalConfig, httpClient),
 	}
 }
 
-// NewFromEnv creates a new client using environment variables
+// NewFromEnv creates a new client using environment variables. Variables
+// that cannot be parsed are ignored; use NewClientFromEnv to have them reported.
 func NewFromEnv() *Client {
 	options := DefaultClientOptions()
+	_ = applyEnv(options)
+	return newClient(options, nil)
+}
+
+// applyEnv overrides options with the EKA_* environment variables that are
+// set, and returns an error for each one that cannot be parsed
+func applyEnv(options *ClientOptions) []*FieldError {
+	var errs []*FieldError
 
-	// Read environment variables
 	if env := os.Getenv("EKA_ENVIRONMENT"); env != "" {
 		options.Environment = Environment(env)
 	}
@@ -236,12 +520,16 @@ func NewFromEnv() *Client {
 	if timeout := os.Getenv("EKA_TIMEOUT"); timeout != "" {
 		if t, err := strconv.Atoi(timeout); err == nil {
 			options.Timeout = time.Duration(t) * time.Second
+		} else {
+			errs = append(errs, envError("EKA_TIMEOUT", timeout, err))
 		}
 	}
 
 	if maxRetries := os.Getenv("EKA_MAX_RETRIES"); maxRetries != "" {
 		if mr, err := strconv.Atoi(maxRetries); err == nil {
 			options.MaxRetries = mr
+		} else {
+			errs = append(errs, envError("EKA_MAX_RETRIES", maxRetries, err))
 		}
 	}
 
@@ -253,9 +541,19 @@ func NewFromEnv() *Client {
 		options.LogLevel = logLevel
 	}
 
+	if enableRetries := os.Getenv("EKA_ENABLE_RETRIES"); enableRetries != "" {
+		if er, err := strconv.ParseBool(enableRetries); err == nil {
+			options.EnableRetries = er
+		} else {
+			errs = append(errs, envError("EKA_ENABLE_RETRIES", enableRetries, err))
+		}
+	}
+
 	if disableSSL := os.Getenv("EKA_DISABLE_SSL"); disableSSL != "" {
 		if ds, err := strconv.ParseBool(disableSSL); err == nil {
 			options.DisableSSL = ds
+		} else {
+			errs = append(errs, envError("EKA_DISABLE_SSL", disableSSL, err))
 		}
 	}
 
@@ -263,16 +561,7 @@ func NewFromEnv() *Client {
 		options.Region = region
 	}
 
-	return New(
-		WithEnvironment(options.Environment),
-		WithClientID(options.ClientID),
-		WithClientSecret(options.ClientSecret),
-		WithTimeout(options.Timeout),
-		WithMaxRetries(options.MaxRetries),
-		WithUserAgent(options.UserAgent),
-		WithLogLevel(options.LogLevel),
-		WithDisableSSL(options.DisableSSL),
-	)
+	return errs
 }
 
 // getBaseURL returns the base URL for the given environment
@@ -288,33 +577,51 @@ func getBaseURL(env Environment) string {
 }
 
 // createABDMClient creates an ABDM client from the internal config
-func createABDMClient(cfg *config.Config) *abdm.Client {
-	// The ABDM client now just organizes services and uses the main config
-	return abdm.NewClient(cfg)
+func createABDMClient(cfg *config.Config, httpClient *ekahttp.Client) *abdm.Client {
+	// The ABDM client just organizes services around the shared config and HTTP client
+	return abdm.NewClient(cfg, httpClient)
 }
 
 // GetCredentials retrieves the current credentials using the configured provider
 func (c *Client) GetCredentials(ctx context.Context) (*auth.Credentials, error) {
-	if c.credentialsProvider == nil {
+	if c.config.IsClosed() {
+		return nil, ErrClientClosed
+	}
+	provider := c.state.currentProvider()
+	if provider == nil {
 		return nil, fmt.Errorf("no credentials provider configured")
 	}
-	return c.credentialsProvider.Retrieve(ctx)
+	return provider.Retrieve(ctx)
 }
 
 // SetCredentialsProvider sets a new credentials provider
 func (c *Client) SetCredentialsProvider(provider auth.CredentialsProvider) {
-	c.credentialsProvider = provider
+	c.state.mu.Lock()
+	defer c.state.mu.Unlock()
+	c.state.provider = provider
 }
 
 // NewClientCredentialsProvider creates a client credentials provider using this client's auth service
 func (c *Client) NewClientCredentialsProvider(req *auth.ClientLoginRequest) *auth.ClientCredentialsProvider {
-	return auth.NewClientCredentialsProvider(c.Auth, req)
+	return auth.NewClientCredentialsProvider(c.Auth, req, c.Auth.RefreshReporting())
 }
 
-// Login performs authentication using client credentials and sets up the client for API calls
+// Login performs authentication using client credentials and sets up the client for API calls.
+//
+// Login is safe to call concurrently with itself, with Close and with calls
+// made through the client's services, which keep working across re-logins.
+// It is idempotent: concurrent and repeated calls share one login and reuse
+// its credentials until they expire.
 func (c *Client) Login(ctx context.Context) error {
 	cfg := c.config.(*config.Config)
 
+	c.state.mu.Lock()
+	defer c.state.mu.Unlock()
+
+	if cfg.IsClosed() {
+		return ErrClientClosed
+	}
+
 	// Check if we have required client credentials
 	if cfg.ClientID == "" {
 		return fmt.Errorf("client ID is required for authentication. Set EKA_CLIENT_ID environment variable or use WithClientID() option")
@@ -324,26 +631,69 @@ func (c *Client) Login(ctx context.Context) error {
 		return fmt.Errorf("client secret is required for authentication. Set EKA_CLIENT_SECRET environment variable or use WithClientSecret() option")
 	}
 
-	// Create a client credentials provider
-	loginRequest := &auth.ClientLoginRequest{
-		ClientID:     cfg.ClientID,
-		ClientSecret: cfg.ClientSecret,
+	// Create a client credentials provider on first login; later logins
+	// reuse its cached credentials, refreshing them once expired
+	if c.state.login == nil {
+		loginRequest := &auth.ClientLoginRequest{
+			ClientID:     cfg.ClientID,
+			ClientSecret: auth.Secret(cfg.ClientSecret),
+		}
+		c.state.login = auth.NewClientCredentialsProvider(c.Auth, loginRequest, c.Auth.RefreshReporting())
 	}
-
-	provider := auth.NewClientCredentialsProvider(c.Auth, loginRequest)
-	c.credentialsProvider = provider
+	c.state.provider = c.state.login
 
 	// Get credentials to trigger initial login
-	credentials, err := provider.Retrieve(ctx)
+	credentials, err := c.state.login.Retrieve(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to authenticate with provided credentials: %w", err)
 	}
 
-	// Set the authorization token in config for ABDM client
-	cfg.SetAuthorizationToken(credentials.AccessToken)
+	// Set the authorization token in config; the shared HTTP client reads it
+	// atomically on every request, so existing service references pick it up
+	cfg.SetAuthorizationToken(credentials.AccessToken.Reveal())
 
-	// Recreate ABDM client with the new token
-	c.ABDM = createABDMClient(cfg)
+	return nil
+}
 
+// Close revokes the client's tokens with the auth server, clears all cached
+// credentials and marks the client as closed. Any further request made through
+// the client or its services fails with ErrClientClosed.
+//
+// The client is closed even if revocation fails; the revocation error is
+// returned so callers can alert on it. Calling Close more than once is a no-op.
+func (c *Client) Close(ctx context.Context) error {
+	cfg := c.config.(*config.Config)
+
+	c.state.mu.Lock()
+	defer c.state.mu.Unlock()
+
+	if cfg.IsClosed() {
+		return nil
+	}
+
+	var revokeErr error
+	switch provider := c.state.provider.(type) {
+	case auth.CredentialsRevoker:
+		revokeErr = provider.Revoke(ctx)
+	default:
+		// Providers that cannot revoke on their own still hand out a token
+		// that was set on the config; revoke it directly.
+		if token := cfg.GetAPIKey(); token != "" {
+			revokeErr = c.Auth.Revoke(ctx, &auth.RevokeTokenRequest{
+				Token:         auth.Secret(token),
+				TokenTypeHint: auth.TokenTypeAccessToken,
+			})
+		}
+	}
+
+	if clearer, ok := c.state.provider.(auth.CredentialsClearer); ok {
+		clearer.Clear()
+	}
+	cfg.SetAuthorizationToken("")
+	cfg.Close()
+
+	if revokeErr != nil {
+		return fmt.Errorf("failed to revoke credentials: %w", revokeErr)
+	}
 	return nil
 }
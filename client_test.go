@@ -0,0 +1,159 @@
+package ekasdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLoggingIsOptIn(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		opts    []Option
+		logging bool
+	}{
+		{name: "default"},
+		{name: "log level", opts: []Option{WithLogLevel("warn")}, logging: true},
+		{name: "off", opts: []Option{WithLogLevel("off")}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(tt.opts...)
+			if got := c.config.GetLogger() != nil; got != tt.logging {
+				t.Errorf("logger installed = %v, want %v", got, tt.logging)
+			}
+		})
+	}
+}
+
+// authServer is an httptest auth server that counts the calls it receives
+type authServer struct {
+	*httptest.Server
+	logins, logouts atomic.Int64
+}
+
+func newAuthServer(t *testing.T) *authServer {
+	t.Helper()
+	s := &authServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/connect-auth/v1/account/login":
+			s.logins.Add(1)
+			// Slow enough for concurrent logins to overlap
+			time.Sleep(10 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"access_token":"access","refresh_token":"refresh","expires_in":3600,"refresh_expires_in":7200}`))
+		case "/connect-auth/v1/account/logout":
+			s.logouts.Add(1)
+		default:
+			_, _ = w.Write([]byte(`{"abha_address":"user@abdm"}`))
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTestClient(srv *authServer, opts ...Option) *Client {
+	return New(append([]Option{
+		WithBaseURL(srv.URL),
+		WithHTTPClient(srv.Client()),
+		WithClientID("client"),
+		WithClientSecret("secret"),
+	}, opts...)...)
+}
+
+func TestLoginIsIdempotent(t *testing.T) {
+	srv := newAuthServer(t)
+	c := newTestClient(srv)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := c.Login(ctx); err != nil {
+				t.Errorf("Login: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	for i := 0; i < 3; i++ {
+		if err := c.Login(ctx); err != nil {
+			t.Fatalf("Login: %v", err)
+		}
+	}
+	if err := c.With(WithTimeout(5 * time.Second)).Login(ctx); err != nil {
+		t.Fatalf("derived Login: %v", err)
+	}
+
+	if got := srv.logins.Load(); got != 1 {
+		t.Errorf("server saw %d ClientLogin calls, want 1", got)
+	}
+}
+
+// TestClientConcurrentUse exercises Login, Close, GetCredentials, service
+// calls and derived clients concurrently; run it with -race
+func TestClientConcurrentUse(t *testing.T) {
+	srv := newAuthServer(t)
+	c := newTestClient(srv)
+	ctx := context.Background()
+	if err := c.Login(ctx); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	// Calls racing with Close either succeed or fail with ErrClientClosed
+	check := func(op string, err error) {
+		if err != nil && !errors.Is(err, ErrClientClosed) {
+			t.Errorf("%s: %v", op, err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			client := c
+			if i%2 == 1 {
+				client = c.With(WithTimeout(5*time.Second), WithDefaultHeaders(Headers{HipID: "hip-2"}))
+			}
+			for j := 0; j < 20; j++ {
+				check("Login", client.Login(ctx))
+				_, err := client.GetCredentials(ctx)
+				check("GetCredentials", err)
+				_, err = client.ABDM.Profile().GetProfile(ctx, Headers{PatientID: "oid"})
+				check("GetProfile", err)
+				_ = client.ABDM.Login()
+				_ = client.ABDM.Registration()
+			}
+		}(i)
+	}
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			time.Sleep(5 * time.Millisecond)
+			check("Close", c.Close(ctx))
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if err := c.Login(ctx); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("Login after Close: err = %v, want ErrClientClosed", err)
+	}
+	if got := srv.logins.Load(); got != 1 {
+		t.Errorf("server saw %d ClientLogin calls, want 1", got)
+	}
+	if got := srv.logouts.Load(); got != 1 {
+		t.Errorf("server saw %d Logout calls, want 1", got)
+	}
+}
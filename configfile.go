@@ -0,0 +1,308 @@
+package ekasdk
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// configFile is the layout of a configuration file
+type configFile struct {
+	// DefaultProfile is used when no profile is requested
+	DefaultProfile string               `yaml:"default_profile"`
+	Profiles       map[string]yaml.Node `yaml:"profiles"`
+}
+
+// fileProfile holds the client options of a named profile. Pointer fields
+// distinguish settings left out of the file from zero values.
+type fileProfile struct {
+	Environment       *string        `yaml:"environment"`
+	BaseURL           *string        `yaml:"base_url"`
+	ClientID          *string        `yaml:"client_id"`
+	ClientSecret      *string        `yaml:"client_secret"`
+	Timeout           *time.Duration `yaml:"timeout"`
+	MaxRetries        *int           `yaml:"max_retries"`
+	UserAgent         *string        `yaml:"user_agent"`
+	LogLevel          *string        `yaml:"log_level"`
+	DisableSSL        *bool          `yaml:"disable_ssl"`
+	Region            *string        `yaml:"region"`
+	RetryMode         *string        `yaml:"retry_mode"`
+	MaxBackoffDelay   *time.Duration `yaml:"max_backoff_delay"`
+	RequestTimeout    *time.Duration `yaml:"request_timeout"`
+	ResponseTimeout   *time.Duration `yaml:"response_timeout"`
+	ConnectionTimeout *time.Duration `yaml:"connection_timeout"`
+
+	StrictPatientContext *bool                           `yaml:"strict_patient_context"`
+	StrictDecoding       *bool                           `yaml:"strict_decoding"`
+	EnableRetries        *bool                           `yaml:"enable_retries"`
+	LogBodies            *bool                           `yaml:"log_bodies"`
+	RateLimits           map[EndpointGroup]fileRateLimit `yaml:"rate_limits"`
+	CircuitBreaker       *fileCircuitBreaker             `yaml:"circuit_breaker"`
+	DefaultHeaders       *fileHeaders                    `yaml:"default_headers"`
+	Header               map[string]fileHeaderValues     `yaml:"header"`
+}
+
+// fileHeaders are default patient headers in a configuration file
+type fileHeaders struct {
+	PatientID     string `yaml:"patient_id"`
+	PartnerUserID string `yaml:"partner_user_id"`
+	HipID         string `yaml:"hip_id"`
+}
+
+// fileHeaderValues are the values of a header in a configuration file,
+// written as a single string or a list
+type fileHeaderValues []string
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (v *fileHeaderValues) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*v = fileHeaderValues{node.Value}
+		return nil
+	}
+	return node.Decode((*[]string)(v))
+}
+
+// fileRateLimit is a rate limit in a configuration file
+type fileRateLimit struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+// fileCircuitBreaker is a circuit breaker configuration in a configuration
+// file; unset fields take the DefaultCircuitBreakerConfig values
+type fileCircuitBreaker struct {
+	FailureThreshold    *int           `yaml:"failure_threshold"`
+	OpenTimeout         *time.Duration `yaml:"open_timeout"`
+	HalfOpenMaxRequests *int           `yaml:"half_open_max_requests"`
+}
+
+// NewFromConfigFile creates a client from a named profile of a YAML or JSON
+// configuration file. If profile is empty, the file's default_profile is
+// used, or its only profile.
+//
+// Settings are resolved in this order, later ones taking precedence:
+// defaults, the file profile, EKA_* environment variables and finally opts.
+// String values may reference environment variables as ${NAME} or
+// ${NAME:-default}, which keeps secrets out of the file:
+//
+//	default_profile: prod
+//	profiles:
+//	  prod:
+//	    environment: production
+//	    client_id: clinic-42
+//	    client_secret: ${EKA_PROD_CLIENT_SECRET}
+//	    timeout: 30s
+//	    rate_limits:
+//	      otp: {rate: 0.5, burst: 2}
+//	    default_headers:
+//	      hip_id: ${EKA_HIP_ID}
+//	    header:
+//	      X-Clinic-Id: clinic-42
+//	  dev:
+//	    environment: development
+//	    client_id: clinic-42-dev
+//	    client_secret: ${EKA_DEV_CLIENT_SECRET}
+//	    log_level: debug
+//
+// Options that cannot be written in a file, such as the HTTP client, logger
+// or credentials provider, are set with opts. The resolved options are
+// validated like NewClient.
+func NewFromConfigFile(path, profile string, opts ...Option) (*Client, error) {
+	options := DefaultClientOptions()
+	if err := applyConfigFile(options, path, profile); err != nil {
+		return nil, err
+	}
+	errs := applyEnv(options)
+	for _, opt := range opts {
+		opt(options)
+	}
+	if err := configError(append(errs, validateOptions(options)...)); err != nil {
+		return nil, err
+	}
+	return newClient(options, nil), nil
+}
+
+// applyConfigFile overrides options with the settings of a file profile
+func applyConfigFile(options *ClientOptions, path, profile string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	// YAML is a superset of JSON, so one decoder reads both formats
+	var file configFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	name, node, err := file.profile(profile)
+	if err != nil {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+	if err := interpolate(&node); err != nil {
+		return fmt.Errorf("config file %s: profile %q: %w", path, name, err)
+	}
+
+	p, err := decodeProfile(&node)
+	if err != nil {
+		return fmt.Errorf("config file %s: profile %q: %w", path, name, err)
+	}
+	p.apply(options)
+	return nil
+}
+
+// profile returns the name and contents of the requested profile
+func (f *configFile) profile(name string) (string, yaml.Node, error) {
+	if name == "" {
+		name = f.DefaultProfile
+	}
+	if name == "" && len(f.Profiles) == 1 {
+		for only := range f.Profiles {
+			name = only
+		}
+	}
+	if name == "" {
+		return "", yaml.Node{}, fmt.Errorf("no profile given and no default_profile set (profiles: %v)", f.profileNames())
+	}
+
+	node, ok := f.Profiles[name]
+	if !ok {
+		return "", yaml.Node{}, fmt.Errorf("profile %q not found (profiles: %v)", name, f.profileNames())
+	}
+	return name, node, nil
+}
+
+// profileNames returns the sorted names of the profiles in the file
+func (f *configFile) profileNames() []string {
+	names := make([]string, 0, len(f.Profiles))
+	for name := range f.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// decodeProfile decodes a profile, rejecting unknown settings
+func decodeProfile(node *yaml.Node) (*fileProfile, error) {
+	data, err := yaml.Marshal(node)
+	if err != nil {
+		return nil, err
+	}
+
+	var p fileProfile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// envReference matches ${NAME} and ${NAME:-default}
+var envReference = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(?::-([^}]*))?\}`)
+
+// interpolate replaces environment variable references in every scalar value
+// of node. Values are substituted after parsing, so they cannot change the
+// structure of the file. Unquoted values are typed after substitution, e.g.
+// max_retries: ${RETRIES} is an integer; quoted values remain strings.
+func interpolate(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		if !envReference.MatchString(node.Value) {
+			return nil
+		}
+		var missing []string
+		node.Value = envReference.ReplaceAllStringFunc(node.Value, func(ref string) string {
+			m := envReference.FindStringSubmatchIndex(ref)
+			name := ref[m[2]:m[3]]
+			if value, ok := os.LookupEnv(name); ok {
+				return value
+			}
+			if m[4] >= 0 {
+				return ref[m[4]:m[5]]
+			}
+			missing = append(missing, name)
+			return ""
+		})
+		if len(missing) > 0 {
+			return fmt.Errorf("line %d: environment variable %s is not set", node.Line, missing[0])
+		}
+		// The parser tagged the reference as a string; let the encoder
+		// resolve the substituted value again
+		if node.Style&(yaml.SingleQuotedStyle|yaml.DoubleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+			node.Tag = ""
+		}
+		return nil
+	}
+
+	for _, child := range node.Content {
+		if err := interpolate(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply overrides options with the settings present in the profile
+func (p *fileProfile) apply(options *ClientOptions) {
+	setIf(&options.Environment, (*Environment)(p.Environment))
+	setIf(&options.BaseURL, p.BaseURL)
+	setIf(&options.ClientID, p.ClientID)
+	setIf(&options.ClientSecret, p.ClientSecret)
+	setIf(&options.Timeout, p.Timeout)
+	setIf(&options.MaxRetries, p.MaxRetries)
+	setIf(&options.UserAgent, p.UserAgent)
+	setIf(&options.LogLevel, p.LogLevel)
+	setIf(&options.DisableSSL, p.DisableSSL)
+	setIf(&options.Region, p.Region)
+	setIf(&options.RetryMode, p.RetryMode)
+	setIf(&options.MaxBackoffDelay, p.MaxBackoffDelay)
+	setIf(&options.RequestTimeout, p.RequestTimeout)
+	setIf(&options.ResponseTimeout, p.ResponseTimeout)
+	setIf(&options.ConnectionTimeout, p.ConnectionTimeout)
+	setIf(&options.StrictPatientContext, p.StrictPatientContext)
+	setIf(&options.StrictDecoding, p.StrictDecoding)
+	setIf(&options.EnableRetries, p.EnableRetries)
+	setIf(&options.LogBodies, p.LogBodies)
+
+	if len(p.RateLimits) > 0 {
+		options.RateLimits = make(map[EndpointGroup]RateLimit, len(p.RateLimits))
+		for group, limit := range p.RateLimits {
+			options.RateLimits[group] = RateLimit{Rate: limit.Rate, Burst: limit.Burst}
+		}
+	}
+
+	if p.DefaultHeaders != nil {
+		options.DefaultHeaders = Headers{
+			PatientID:     p.DefaultHeaders.PatientID,
+			PartnerUserID: p.DefaultHeaders.PartnerUserID,
+			HipID:         p.DefaultHeaders.HipID,
+		}
+	}
+	if len(p.Header) > 0 {
+		options.Header = make(http.Header, len(p.Header))
+		for key, values := range p.Header {
+			options.Header[http.CanonicalHeaderKey(key)] = values
+		}
+	}
+
+	if p.CircuitBreaker != nil {
+		cb := DefaultCircuitBreakerConfig()
+		setIf(&cb.FailureThreshold, p.CircuitBreaker.FailureThreshold)
+		setIf(&cb.OpenTimeout, p.CircuitBreaker.OpenTimeout)
+		setIf(&cb.HalfOpenMaxRequests, p.CircuitBreaker.HalfOpenMaxRequests)
+		options.CircuitBreaker = &cb
+	}
+}
+
+// setIf sets *dst to *src if src is not nil
+func setIf[T any](dst *T, src *T) {
+	if src != nil {
+		*dst = *src
+	}
+}
@@ -0,0 +1,173 @@
+package ekasdk
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeConfigFile writes a configuration file with a single profile
+func writeConfigFile(t *testing.T, profile string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "eka.yaml")
+	data := "profiles:\n  test:\n" + indent(profile, "    ")
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// indent replaces the tab indentation of the lines of s with prefix
+func indent(s, prefix string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	for i, line := range lines {
+		lines[i] = prefix + strings.TrimLeft(line, "\t")
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func TestConfigFileInterpolatesEveryFieldKind(t *testing.T) {
+	t.Setenv("T_ENV", "development")
+	t.Setenv("T_ID", "clinic-42")
+	t.Setenv("T_SECRET", "12345")
+	t.Setenv("T_TIMEOUT", "45s")
+	t.Setenv("T_RETRIES", "5")
+	t.Setenv("T_BOOL", "true")
+	t.Setenv("T_RATE", "0.5")
+	t.Setenv("T_BURST", "2")
+
+	path := writeConfigFile(t, `
+		environment: ${T_ENV}
+		client_id: ${T_ID}
+		client_secret: ${T_SECRET}
+		user_agent: "${T_RETRIES}"
+		timeout: ${T_TIMEOUT}
+		max_retries: ${T_RETRIES}
+		enable_retries: ${T_BOOL}
+		disable_ssl: ${T_BOOL}
+		strict_decoding: ${T_BOOL}
+		rate_limits:
+		  otp:
+		    rate: ${T_RATE}
+		    burst: ${T_BURST}
+		circuit_breaker:
+		  failure_threshold: ${T_RETRIES}
+		  open_timeout: ${T_TIMEOUT}
+	`)
+	c, err := NewFromConfigFile(path, "")
+	if err != nil {
+		t.Fatalf("NewFromConfigFile: %v", err)
+	}
+
+	o := c.options
+	if o.Environment != EnvironmentDevelopment || o.ClientID != "clinic-42" || o.ClientSecret != "12345" || o.UserAgent != "5" {
+		t.Errorf("strings = %q, %q, %q, %q", o.Environment, o.ClientID, o.ClientSecret, o.UserAgent)
+	}
+	if o.Timeout != 45*time.Second {
+		t.Errorf("Timeout = %s, want 45s", o.Timeout)
+	}
+	if o.MaxRetries != 5 {
+		t.Errorf("MaxRetries = %d, want 5", o.MaxRetries)
+	}
+	if !o.EnableRetries || !o.DisableSSL || !o.StrictDecoding {
+		t.Errorf("bools = %v, %v, %v, want all true", o.EnableRetries, o.DisableSSL, o.StrictDecoding)
+	}
+	if limit := o.RateLimits[EndpointGroupOTP]; limit.Rate != 0.5 || limit.Burst != 2 {
+		t.Errorf("otp rate limit = %+v", limit)
+	}
+	if cb := o.CircuitBreaker; cb == nil || cb.FailureThreshold != 5 || cb.OpenTimeout != 45*time.Second {
+		t.Errorf("circuit breaker = %+v", cb)
+	}
+}
+
+func TestConfigFileDefaultsAndMissingVariables(t *testing.T) {
+	t.Setenv("T_SET", "from-env")
+
+	path := writeConfigFile(t, `
+		client_id: ${T_SET:-unused}
+		client_secret: ${T_UNSET_SECRET:-fallback}
+		max_retries: ${T_UNSET_RETRIES:-7}
+		disable_ssl: ${T_UNSET_SSL:-false}
+	`)
+	c, err := NewFromConfigFile(path, "")
+	if err != nil {
+		t.Fatalf("NewFromConfigFile: %v", err)
+	}
+	if c.options.ClientID != "from-env" || c.options.ClientSecret != "fallback" {
+		t.Errorf("ClientID, ClientSecret = %q, %q", c.options.ClientID, c.options.ClientSecret)
+	}
+	if c.options.MaxRetries != 7 || c.options.DisableSSL {
+		t.Errorf("MaxRetries, DisableSSL = %d, %v; want 7, false", c.options.MaxRetries, c.options.DisableSSL)
+	}
+
+	path = writeConfigFile(t, `
+		client_id: clinic-42
+		client_secret: ${T_MISSING_SECRET}
+	`)
+	_, err = NewFromConfigFile(path, "")
+	if err == nil || !strings.Contains(err.Error(), "T_MISSING_SECRET is not set") {
+		t.Fatalf("err = %v, want missing variable error", err)
+	}
+}
+
+func TestConfigFilePrecedence(t *testing.T) {
+	t.Setenv("T_BASE_URL", "https://gateway.example.com")
+	t.Setenv("T_HIP", "hip-1")
+	t.Setenv("T_CLINIC", "clinic-42")
+
+	path := writeConfigFile(t, `
+		client_id: from-file
+		client_secret: from-file
+		timeout: 10s
+		max_retries: 1
+		user_agent: from-file
+		base_url: ${T_BASE_URL}
+		default_headers:
+		  hip_id: ${T_HIP}
+		  partner_user_id: from-file
+		header:
+		  x-clinic-id: ${T_CLINIC}
+		  X-Tags: [a, "${T_HIP}"]
+	`)
+	// The environment overrides the file, explicit options override both
+	t.Setenv("EKA_TIMEOUT", "20")
+	t.Setenv("EKA_MAX_RETRIES", "2")
+
+	c, err := NewFromConfigFile(path, "test", WithMaxRetries(4),
+		WithDefaultHeaders(Headers{PartnerUserID: "from-option"}),
+		WithHeader("X-Request-Source", "from-option"))
+	if err != nil {
+		t.Fatalf("NewFromConfigFile: %v", err)
+	}
+	o := c.options
+	if o.UserAgent != "from-file" {
+		t.Errorf("UserAgent = %q, want the file value", o.UserAgent)
+	}
+	if o.Timeout != 20*time.Second {
+		t.Errorf("Timeout = %s, want the environment value 20s", o.Timeout)
+	}
+	if o.MaxRetries != 4 {
+		t.Errorf("MaxRetries = %d, want the option value 4", o.MaxRetries)
+	}
+	if o.BaseURL != "https://gateway.example.com" {
+		t.Errorf("BaseURL = %q, want the interpolated file value", o.BaseURL)
+	}
+	if want := (Headers{HipID: "hip-1", PartnerUserID: "from-option"}); o.DefaultHeaders != want {
+		t.Errorf("DefaultHeaders = %+v, want %+v", o.DefaultHeaders, want)
+	}
+	want := http.Header{
+		"X-Clinic-Id":      {"clinic-42"},
+		"X-Tags":           {"a", "hip-1"},
+		"X-Request-Source": {"from-option"},
+	}
+	if !reflect.DeepEqual(o.Header, want) {
+		t.Errorf("Header = %v, want %v", o.Header, want)
+	}
+	if o.ConnectionTimeout != DefaultClientOptions().ConnectionTimeout {
+		t.Errorf("ConnectionTimeout = %s, want the default", o.ConnectionTimeout)
+	}
+}
@@ -0,0 +1,49 @@
+package ekasdk
+
+import (
+	"context"
+
+	"github.com/eka-care/eka-sdk-go/internal/errors"
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// Headers identifies the patient, partner user and HIP an ABDM call is made for
+type Headers = interfaces.Headers
+
+// ErrMissingPatientContext is returned in strict mode for ABDM calls made
+// without patient headers, see WithStrictPatientContext
+var ErrMissingPatientContext = errors.ErrMissingPatientContext
+
+// ContextWithPatient returns a copy of ctx carrying the given patient headers.
+//
+// Every SDK call made with the returned context sends these headers. Headers
+// passed explicitly to a service method take precedence field by field, so a
+// middleware can set the patient once and individual calls can still override
+// a single value:
+//
+//	ctx = ekasdk.ContextWithPatient(ctx, ekasdk.Headers{
+//		PatientID:     oid,
+//		PartnerUserID: userID,
+//		HipID:         hipID,
+//	})
+//	resp, err := client.ABDM.Profile().GetProfile(ctx, ekasdk.Headers{})
+func ContextWithPatient(ctx context.Context, headers Headers) context.Context {
+	return interfaces.ContextWithHeaders(ctx, headers)
+}
+
+// PatientFromContext returns the patient headers stored in ctx by ContextWithPatient
+func PatientFromContext(ctx context.Context) (Headers, bool) {
+	return interfaces.HeadersFromContext(ctx)
+}
+
+// WithDefaultHeaders sets patient headers used by every call that leaves them
+// empty, both in its explicit headers and in its context. Fields left empty
+// in headers keep their previous default, so a derived client can override
+// just the HIP ID:
+//
+//	hip := client.With(ekasdk.WithDefaultHeaders(ekasdk.Headers{HipID: "hip-2"}))
+func WithDefaultHeaders(headers Headers) Option {
+	return func(opts *ClientOptions) {
+		opts.DefaultHeaders = headers.Merge(opts.DefaultHeaders)
+	}
+}
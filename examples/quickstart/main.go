@@ -6,7 +6,6 @@ import (
 	"log"
 
 	ekasdk "github.com/eka-care/eka-sdk-go"
-	"github.com/eka-care/eka-sdk-go/internal/interfaces"
 	"github.com/eka-care/eka-sdk-go/services/abdm/abha/login"
 )
 
@@ -31,7 +30,7 @@ func main() {
 	fmt.Println("✅ Client authenticated with Eka Care platform!")
 
 	// Step 3: Use ABDM login APIs
-	headers := interfaces.Headers{
+	headers := ekasdk.Headers{
 		PatientID:     "eka-user-oid",
 		PartnerUserID: "your-user-id",
 		HipID:         "your-hip-id",
@@ -0,0 +1,35 @@
+package ekasdk
+
+import "github.com/eka-care/eka-sdk-go/internal/interfaces"
+
+// Hooks are callbacks invoked synchronously on SDK events. Every field is
+// optional; callbacks must be fast and must not call back into the client.
+type Hooks = interfaces.Hooks
+
+// Hook event payloads
+type (
+	// RequestStartEvent describes a service call about to be sent
+	RequestStartEvent = interfaces.RequestStartEvent
+	// ResponseEvent describes a completed service call
+	ResponseEvent = interfaces.ResponseEvent
+	// RetryEvent describes a retry of a failed HTTP attempt
+	RetryEvent = interfaces.RetryEvent
+	// TokenRefreshedEvent describes a successful access token refresh
+	TokenRefreshedEvent = interfaces.TokenRefreshedEvent
+	// TokenRefreshFailedEvent describes a failed access token refresh
+	TokenRefreshFailedEvent = interfaces.TokenRefreshFailedEvent
+	// SkipStateEvent reports the next step of an ABDM flow
+	SkipStateEvent = interfaces.SkipStateEvent
+	// AbhaCreatedEvent describes a newly created ABHA address
+	AbhaCreatedEvent = interfaces.AbhaCreatedEvent
+	// AuditErrorEvent describes an audit entry the audit sink failed to record
+	AuditErrorEvent = interfaces.AuditErrorEvent
+)
+
+// WithHooks installs lifecycle hooks, e.g. to alert when a token refresh
+// fails or to record an audit entry when an ABHA address is created
+func WithHooks(hooks Hooks) Option {
+	return func(opts *ClientOptions) {
+		opts.Hooks = &hooks
+	}
+}
@@ -0,0 +1,40 @@
+package ekasdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eka-care/eka-sdk-go/services/abdm/abha/registration"
+)
+
+func TestAbhaCreatedReportsMergedPatientHeaders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"skip_state":"abha_end","profile":{"abha_address":"new@abdm","gender":"F"},"eka":{"oid":"oid-9","min_token":"m"}}`))
+	}))
+	defer srv.Close()
+
+	var events []AbhaCreatedEvent
+	c := New(
+		WithBaseURL(srv.URL),
+		WithHTTPClient(srv.Client()),
+		WithDefaultHeaders(Headers{HipID: "hip-default"}),
+		WithHooks(Hooks{OnAbhaCreated: func(ctx context.Context, e AbhaCreatedEvent) {
+			events = append(events, e)
+		}}),
+	)
+	ctx := ContextWithPatient(context.Background(), Headers{PatientID: "oid-ctx", PartnerUserID: "user-ctx"})
+
+	_, err := c.ABDM.Registration().AadhaarCreatePHR(ctx, Headers{PartnerUserID: "user-explicit"},
+		registration.CreateRequest{TxnID: "txn", AbhaAddress: "new@abdm"})
+	if err != nil {
+		t.Fatalf("AadhaarCreatePHR: %v", err)
+	}
+
+	want := Headers{PatientID: "oid-ctx", PartnerUserID: "user-explicit", HipID: "hip-default"}
+	if len(events) != 1 || events[0].Patient != want || events[0].AbhaAddress != "new@abdm" || events[0].OID != "oid-9" {
+		t.Errorf("events = %+v, want one with patient %+v", events, want)
+	}
+}
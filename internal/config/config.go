@@ -1,9 +1,14 @@
 package config
 
 import (
+	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/eka-care/eka-sdk-go/internal/interfaces"
 )
 
@@ -29,23 +34,65 @@ func (e Environment) GetBaseURL() string {
 
 // Config holds the internal configuration for the ABDM client
 type Config struct {
-	Environment        Environment
-	BaseURL            string
-	ClientID           string // Client ID for authentication
-	ClientSecret       string // Client Secret for authentication
-	AuthorizationToken string // JWT token for API calls (set after login)
-	Timeout            time.Duration
-	MaxRetries         int
-	UserAgent          string
-	LogLevel           string
-	HTTPClient         *http.Client
-	DisableSSL         bool
-	Region             string
-	RetryMode          string
-	MaxBackoffDelay    time.Duration
-	RequestTimeout     time.Duration
-	ResponseTimeout    time.Duration
-	ConnectionTimeout  time.Duration
+	Environment       Environment
+	BaseURL           string
+	ClientID          string // Client ID for authentication
+	ClientSecret      string // Client Secret for authentication
+	Timeout           time.Duration
+	MaxRetries        int
+	UserAgent         string
+	LogLevel          string
+	HTTPClient        *http.Client
+	DisableSSL        bool
+	Region            string
+	RetryMode         string
+	MaxBackoffDelay   time.Duration
+	RequestTimeout    time.Duration
+	ResponseTimeout   time.Duration
+	ConnectionTimeout time.Duration
+
+	// StrictPatientContext makes ABDM calls fail when no patient headers are
+	// supplied either explicitly or through the context
+	StrictPatientContext bool
+
+	// StrictDecoding makes responses with unknown fields fail with an
+	// UnknownFieldsError
+	StrictDecoding bool
+
+	// EnableRetries retries failed attempts up to MaxRetries times
+	EnableRetries bool
+
+	// RateLimits configures client-side rate limiting per endpoint group
+	RateLimits map[interfaces.EndpointGroup]interfaces.RateLimit
+
+	// CircuitBreaker enables the circuit breaker when non-nil
+	CircuitBreaker *interfaces.CircuitBreakerConfig
+
+	// Logger receives structured request logs; nil disables logging
+	Logger *slog.Logger
+	// LogBodies logs masked request and response bodies at debug level
+	LogBodies bool
+
+	// TracerProvider enables OpenTelemetry tracing when non-nil
+	TracerProvider trace.TracerProvider
+
+	// Metrics receives request, retry, token refresh, OTP and error metrics
+	Metrics interfaces.MetricsCollector
+	// Hooks are called synchronously on SDK events
+	Hooks *interfaces.Hooks
+	// AuditSink receives an entry for every ABDM call
+	AuditSink interfaces.AuditSink
+
+	// DefaultHeaders fill the patient headers a call leaves empty
+	DefaultHeaders interfaces.Headers
+	// Header is sent with every request; per-request headers take precedence
+	Header http.Header
+	// TransportMiddleware wraps every attempt, below retries
+	TransportMiddleware []interfaces.Middleware
+
+	// Session holds the authorization token and closed state and is shared
+	// with derived configurations; it must not be nil
+	Session *Session
 }
 
 // Ensure Config implements interfaces.Config
@@ -59,12 +106,13 @@ func NewConfig() *Config {
 		Timeout:           30 * time.Second,
 		MaxRetries:        3,
 		UserAgent:         "eka-sdk-go/1.0",
-		LogLevel:          "info",
+		LogLevel:          "",
 		RetryMode:         "standard",
 		MaxBackoffDelay:   20 * time.Second,
 		RequestTimeout:    30 * time.Second,
 		ResponseTimeout:   30 * time.Second,
 		ConnectionTimeout: 10 * time.Second,
+		Session:           NewSession(),
 	}
 }
 
@@ -73,7 +121,7 @@ func (c *Config) GetEnvironment() Environment { return c.Environment }
 func (c *Config) GetBaseURL() string          { return c.BaseURL }
 func (c *Config) GetAPIKey() string {
 	// For API calls, we use the JWT authorization token
-	return c.AuthorizationToken
+	return c.Session.Token()
 }
 func (c *Config) GetTimeout() time.Duration           { return c.Timeout }
 func (c *Config) GetMaxRetries() int                  { return c.MaxRetries }
@@ -87,6 +135,37 @@ func (c *Config) GetMaxBackoffDelay() time.Duration   { return c.MaxBackoffDelay
 func (c *Config) GetRequestTimeout() time.Duration    { return c.RequestTimeout }
 func (c *Config) GetResponseTimeout() time.Duration   { return c.ResponseTimeout }
 func (c *Config) GetConnectionTimeout() time.Duration { return c.ConnectionTimeout }
+func (c *Config) GetStrictPatientContext() bool       { return c.StrictPatientContext }
+func (c *Config) GetStrictDecoding() bool             { return c.StrictDecoding }
+func (c *Config) GetEnableRetries() bool              { return c.EnableRetries }
+
+// GetRateLimits returns the client-side rate limits per endpoint group
+func (c *Config) GetRateLimits() map[interfaces.EndpointGroup]interfaces.RateLimit {
+	return c.RateLimits
+}
+
+// GetLogger returns the structured logger, or nil if logging is disabled
+func (c *Config) GetLogger() *slog.Logger { return c.Logger }
+
+// GetLogBodies reports whether masked bodies are logged at debug level
+func (c *Config) GetLogBodies() bool { return c.LogBodies }
+
+// GetTracerProvider returns the OpenTelemetry tracer provider, or nil if tracing is disabled
+func (c *Config) GetTracerProvider() trace.TracerProvider { return c.TracerProvider }
+
+// GetMetricsCollector returns the metrics collector, or nil if metrics are disabled
+func (c *Config) GetMetricsCollector() interfaces.MetricsCollector { return c.Metrics }
+
+// GetHooks returns the lifecycle hooks, or nil if none are set
+func (c *Config) GetHooks() *interfaces.Hooks { return c.Hooks }
+
+// GetAuditSink returns the audit sink, or nil if auditing is disabled
+func (c *Config) GetAuditSink() interfaces.AuditSink { return c.AuditSink }
+
+// GetCircuitBreaker returns the circuit breaker configuration, or nil if disabled
+func (c *Config) GetCircuitBreaker() *interfaces.CircuitBreakerConfig {
+	return c.CircuitBreaker
+}
 
 // GetClientID returns the client ID for authentication
 func (c *Config) GetClientID() string { return c.ClientID }
@@ -94,5 +173,66 @@ func (c *Config) GetClientID() string { return c.ClientID }
 // GetClientSecret returns the client secret for authentication
 func (c *Config) GetClientSecret() string { return c.ClientSecret }
 
+// GetDefaultHeaders returns the patient headers used when a call leaves them empty
+func (c *Config) GetDefaultHeaders() interfaces.Headers { return c.DefaultHeaders }
+
+// GetHeader returns the headers sent with every request
+func (c *Config) GetHeader() http.Header { return c.Header }
+
+// GetTransportMiddleware returns the middleware installed closest to the network
+func (c *Config) GetTransportMiddleware() []interfaces.Middleware { return c.TransportMiddleware }
+
 // SetAuthorizationToken sets the JWT token for API calls
-func (c *Config) SetAuthorizationToken(token string) { c.AuthorizationToken = token }
+func (c *Config) SetAuthorizationToken(token string) { c.Session.SetToken(token) }
+
+// Close marks the configuration, and every configuration sharing its
+// session, as closed; HTTP clients built from them refuse further requests
+func (c *Config) Close() { c.Session.Close() }
+
+// IsClosed reports whether Close has been called
+func (c *Config) IsClosed() bool { return c.Session.IsClosed() }
+
+// String returns a representation of the configuration with the client secret
+// and authorization token redacted
+func (c *Config) String() string {
+	return fmt.Sprintf("Config{Environment: %s, BaseURL: %s, ClientID: %s, ClientSecret: %s, AuthorizationToken: %s, "+
+		"Timeout: %s, MaxRetries: %d, UserAgent: %s, LogLevel: %s, DisableSSL: %t, Region: %s, RetryMode: %s, "+
+		"MaxBackoffDelay: %s, RequestTimeout: %s, ResponseTimeout: %s, ConnectionTimeout: %s}",
+		c.Environment, c.BaseURL, c.ClientID, redact(c.ClientSecret), redact(c.GetAPIKey()),
+		c.Timeout, c.MaxRetries, c.UserAgent, c.LogLevel, c.DisableSSL, c.Region, c.RetryMode,
+		c.MaxBackoffDelay, c.RequestTimeout, c.ResponseTimeout, c.ConnectionTimeout)
+}
+
+// Format implements fmt.Formatter so that no verb can print the secrets
+func (c *Config) Format(f fmt.State, verb rune) {
+	s := c.String()
+	if verb == 'q' {
+		s = fmt.Sprintf("%q", s)
+	}
+	_, _ = io.WriteString(f, s)
+}
+
+// LogValue implements slog.LogValuer with the secrets redacted
+func (c *Config) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("environment", string(c.Environment)),
+		slog.String("base_url", c.BaseURL),
+		slog.String("client_id", c.ClientID),
+		slog.String("client_secret", redact(c.ClientSecret)),
+		slog.String("authorization_token", redact(c.GetAPIKey())),
+		slog.Duration("timeout", c.Timeout),
+		slog.Int("max_retries", c.MaxRetries),
+		slog.String("user_agent", c.UserAgent),
+		slog.String("log_level", c.LogLevel),
+		slog.Bool("disable_ssl", c.DisableSSL),
+		slog.String("region", c.Region),
+	)
+}
+
+// redact hides a non-empty secret value
+func redact(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "[REDACTED]"
+}
@@ -0,0 +1,33 @@
+package config
+
+import "sync/atomic"
+
+// Session is the authentication state shared by a configuration and every
+// configuration derived from it, so that derived clients use the parent's
+// token and are closed along with it
+type Session struct {
+	token  atomic.Pointer[string]
+	closed atomic.Bool
+}
+
+// NewSession creates an empty session
+func NewSession() *Session {
+	return &Session{}
+}
+
+// Token returns the authorization token, or "" before login
+func (s *Session) Token() string {
+	if token := s.token.Load(); token != nil {
+		return *token
+	}
+	return ""
+}
+
+// SetToken replaces the authorization token
+func (s *Session) SetToken(token string) { s.token.Store(&token) }
+
+// Close marks the session as closed
+func (s *Session) Close() { s.closed.Store(true) }
+
+// IsClosed reports whether Close has been called
+func (s *Session) IsClosed() bool { return s.closed.Load() }
@@ -1,10 +1,64 @@
 package errors
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 )
 
+// ErrClientClosed is returned for requests made through a client after Close
+var ErrClientClosed = errors.New("eka client is closed")
+
+// ErrRateLimited is returned when waiting for the client-side rate limiter
+// would exceed the request's context deadline
+var ErrRateLimited = errors.New("client-side rate limit exceeded")
+
+// ErrCircuitOpen is matched by errors returned while a circuit breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitOpenError is returned without sending the request while the circuit
+// of its host and endpoint group is open
+type CircuitOpenError struct {
+	Key        string        // Host and endpoint group of the circuit
+	RetryAfter time.Duration // Time until the circuit lets a probe request through
+}
+
+// Error implements the error interface
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker is open for %s, retry after %s", e.Key, e.RetryAfter)
+}
+
+// Is makes errors.Is(err, ErrCircuitOpen) match
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// ErrUnknownFields is matched by the errors returned in strict decoding mode
+// for responses carrying fields the SDK does not know
+var ErrUnknownFields = errors.New("response has unknown fields")
+
+// UnknownFieldsError lists the unknown fields of a response in strict
+// decoding mode
+type UnknownFieldsError struct {
+	Fields []string // Paths of the unknown fields, e.g. profile.new_attribute
+}
+
+// Error implements the error interface
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrUnknownFields, strings.Join(e.Fields, ", "))
+}
+
+// Is makes errors.Is(err, ErrUnknownFields) match
+func (e *UnknownFieldsError) Is(target error) bool {
+	return target == ErrUnknownFields
+}
+
+// ErrMissingPatientContext is returned in strict mode for ABDM calls made
+// without patient headers
+var ErrMissingPatientContext = errors.New("ABDM request has no patient context: pass headers explicitly or use ContextWithPatient")
+
 // APIError represents an error returned by the ABDM API
 type APIError struct {
 	Code        int    `json:"code"`
@@ -0,0 +1,77 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// isABDMOperation returns true for operations of the ABDM services, which
+// access or modify a patient's ABHA data
+func isABDMOperation(operation string) bool {
+	return strings.HasPrefix(operation, "abdm.")
+}
+
+// recordAudit sends the audit entry of a completed call to the audit sink and
+// reports sink failures to the OnAuditError hook and the logger
+func (c *Client) recordAudit(ctx context.Context, req *interfaces.HTTPRequest, headers interfaces.Headers, md interfaces.ResponseMetadata, err error) {
+	entry := interfaces.AuditEntry{
+		Timestamp:     time.Now().UTC(),
+		Operation:     req.Operation,
+		PartnerUserID: headers.PartnerUserID,
+		HipID:         headers.HipID,
+		PatientOID:    headers.PatientID,
+		StatusCode:    md.StatusCode,
+		RequestID:     md.RequestID,
+	}
+	if c.config != nil {
+		entry.ClientID = c.config.GetClientID()
+	}
+
+	var apiErr *APIError
+	switch {
+	case err == nil:
+		entry.Outcome = interfaces.AuditOutcomeSuccess
+		// Registration returns the OID of a newly created patient
+		if entry.PatientOID == "" {
+			entry.PatientOID = patientOID(md.Body)
+		}
+	case stderrors.As(err, &apiErr):
+		entry.Outcome = interfaces.AuditOutcomeFailure
+		entry.ErrorCode = apiErr.SourceCode
+	case md.StatusCode != 0:
+		entry.Outcome = interfaces.AuditOutcomeFailure
+	default:
+		entry.Outcome = interfaces.AuditOutcomeError
+	}
+
+	auditErr := c.audit.Record(ctx, entry)
+	if auditErr == nil {
+		return
+	}
+	if c.hooks != nil && c.hooks.OnAuditError != nil {
+		c.hooks.OnAuditError(ctx, interfaces.AuditErrorEvent{Entry: entry, Err: auditErr})
+	}
+	if c.config != nil {
+		if logger := c.config.GetLogger(); logger != nil {
+			logger.ErrorContext(ctx, "failed to record audit entry", "operation", req.Operation, "error", auditErr)
+		}
+	}
+}
+
+// patientOID extracts the Eka patient OID from a response body
+func patientOID(body []byte) string {
+	var v struct {
+		Eka *struct {
+			OID string `json:"oid"`
+		} `json:"eka"`
+	}
+	if err := json.Unmarshal(body, &v); err != nil || v.Eka == nil {
+		return ""
+	}
+	return v.Eka.OID
+}
@@ -0,0 +1,110 @@
+package http
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/eka-care/eka-sdk-go/internal/config"
+	"github.com/eka-care/eka-sdk-go/internal/errors"
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// auditSinkFunc adapts a function to interfaces.AuditSink
+type auditSinkFunc func(ctx context.Context, entry interfaces.AuditEntry) error
+
+func (f auditSinkFunc) Record(ctx context.Context, entry interfaces.AuditEntry) error {
+	return f(ctx, entry)
+}
+
+// profileRequest is an audited ABDM call
+func profileRequest() *interfaces.HTTPRequest {
+	return &interfaces.HTTPRequest{
+		Method:    http.MethodGet,
+		Path:      "/abdm/na/v1/profile",
+		Operation: "abdm.profile.GetProfile",
+		Headers:   interfaces.Headers{PatientID: "oid-1"},
+	}
+}
+
+func TestAuditSinkErrorsAreReported(t *testing.T) {
+	srv := okServer()
+	defer srv.Close()
+
+	sinkErr := stderrors.New("disk full")
+	var events []interfaces.AuditErrorEvent
+	cfg := config.NewConfig()
+	cfg.BaseURL = srv.URL
+	cfg.HTTPClient = srv.Client()
+	cfg.AuditSink = auditSinkFunc(func(ctx context.Context, entry interfaces.AuditEntry) error {
+		return sinkErr
+	})
+	cfg.Hooks = &interfaces.Hooks{OnAuditError: func(ctx context.Context, event interfaces.AuditErrorEvent) {
+		events = append(events, event)
+	}}
+	c := NewClientFromInterface(cfg)
+
+	if _, err := c.Do(context.Background(), profileRequest()); err != nil {
+		t.Fatalf("Do failed because of the audit sink: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("OnAuditError called %d times, want 1", len(events))
+	}
+	if e := events[0]; !stderrors.Is(e.Err, sinkErr) || e.Entry.Operation != "abdm.profile.GetProfile" || e.Entry.PatientOID != "oid-1" {
+		t.Errorf("event = %+v", e)
+	}
+}
+
+func TestRejectedCallsAreAudited(t *testing.T) {
+	srv := okServer()
+	defer srv.Close()
+
+	for _, tt := range []struct {
+		name    string
+		setup   func(cfg *config.Config)
+		headers interfaces.Headers
+		want    error
+	}{
+		{
+			name:    "closed client",
+			setup:   func(cfg *config.Config) { cfg.Close() },
+			headers: interfaces.Headers{PatientID: "oid-1"},
+			want:    errors.ErrClientClosed,
+		},
+		{
+			name:  "missing patient context",
+			setup: func(cfg *config.Config) { cfg.StrictPatientContext = true },
+			want:  errors.ErrMissingPatientContext,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var entries []interfaces.AuditEntry
+			var responses []interfaces.ResponseEvent
+			cfg := config.NewConfig()
+			cfg.BaseURL = srv.URL
+			cfg.HTTPClient = srv.Client()
+			cfg.AuditSink = auditSinkFunc(func(ctx context.Context, entry interfaces.AuditEntry) error {
+				entries = append(entries, entry)
+				return nil
+			})
+			cfg.Hooks = &interfaces.Hooks{OnResponse: func(ctx context.Context, event interfaces.ResponseEvent) {
+				responses = append(responses, event)
+			}}
+			tt.setup(cfg)
+			c := NewClientFromInterface(cfg)
+
+			req := profileRequest()
+			req.Headers = tt.headers
+			if _, err := c.Do(context.Background(), req); !stderrors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if len(entries) != 1 || entries[0].Outcome != interfaces.AuditOutcomeError || entries[0].PatientOID != tt.headers.PatientID {
+				t.Errorf("audit entries = %+v, want one error entry", entries)
+			}
+			if len(responses) != 1 || !stderrors.Is(responses[0].Err, tt.want) {
+				t.Errorf("OnResponse events = %+v", responses)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package http
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// extraFieldName is the field that receives unknown JSON fields of a response
+// struct. It must have type map[string]json.RawMessage.
+const extraFieldName = "Extra"
+
+var (
+	rawMapType         = reflect.TypeOf(map[string]json.RawMessage(nil))
+	jsonUnmarshalerT   = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerT   = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	structFieldsByType sync.Map // reflect.Type -> *structFields
+)
+
+// structFields describes how the JSON object keys of a struct map to its fields
+type structFields struct {
+	byName map[string][]int // JSON name to field index path
+	names  []string         // JSON names, for case-insensitive matching
+	extra  []int            // index of the Extra field, or nil
+}
+
+// fieldsOf returns the cached JSON field layout of a struct type
+func fieldsOf(t reflect.Type) *structFields {
+	if f, ok := structFieldsByType.Load(t); ok {
+		return f.(*structFields)
+	}
+	f := &structFields{byName: make(map[string][]int)}
+	collectFields(t, nil, f)
+	actual, _ := structFieldsByType.LoadOrStore(t, f)
+	return actual.(*structFields)
+}
+
+// collectFields adds the JSON fields of t, including those promoted from
+// embedded structs, to f
+func collectFields(t reflect.Type, index []int, f *structFields) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		path := append(append([]int(nil), index...), i)
+
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				collectFields(ft, path, f)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if field.Name == extraFieldName && field.Type == rawMapType && len(index) == 0 {
+			f.extra = path
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if _, dup := f.byName[name]; !dup {
+			f.byName[name] = path
+			f.names = append(f.names, name)
+		}
+	}
+}
+
+// lookup returns the field for a JSON key, matching case-insensitively like
+// encoding/json
+func (f *structFields) lookup(key string) ([]int, bool) {
+	if index, ok := f.byName[key]; ok {
+		return index, true
+	}
+	for _, name := range f.names {
+		if strings.EqualFold(name, key) {
+			return f.byName[name], true
+		}
+	}
+	return nil, false
+}
+
+// captureExtra stores the JSON fields of raw that v has no field for in the
+// Extra field of v, recursing into nested structs, slices and maps. It
+// returns the paths of every unknown field, e.g. profile.new_attribute.
+func captureExtra(v reflect.Value, raw json.RawMessage, path string) []string {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.CanAddr() {
+		if pt := v.Addr().Type(); pt.Implements(jsonUnmarshalerT) || pt.Implements(textUnmarshalerT) {
+			return nil
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &object); err != nil || object == nil {
+			return nil
+		}
+		fields := fieldsOf(v.Type())
+
+		var unknown []string
+		extra := make(map[string]json.RawMessage)
+		for key, value := range object {
+			index, ok := fields.lookup(key)
+			if !ok {
+				extra[key] = value
+				unknown = append(unknown, joinPath(path, key))
+				continue
+			}
+			if fv, err := v.FieldByIndexErr(index); err == nil {
+				unknown = append(unknown, captureExtra(fv, value, joinPath(path, key))...)
+			}
+		}
+		if len(extra) > 0 && fields.extra != nil {
+			v.FieldByIndex(fields.extra).Set(reflect.ValueOf(extra))
+		}
+		sort.Strings(unknown)
+		return unknown
+
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil
+		}
+		var unknown []string
+		for i := 0; i < len(items) && i < v.Len(); i++ {
+			unknown = append(unknown, captureExtra(v.Index(i), items[i], path+"["+strconv.Itoa(i)+"]")...)
+		}
+		return unknown
+
+	case reflect.Map:
+		// Map values are not addressable, so only pointer elements can be filled
+		if v.Type().Key().Kind() != reflect.String || v.Type().Elem().Kind() != reflect.Pointer {
+			return nil
+		}
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &object); err != nil {
+			return nil
+		}
+		var unknown []string
+		for key, value := range object {
+			if elem := v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key())); elem.IsValid() {
+				unknown = append(unknown, captureExtra(elem, value, joinPath(path, key))...)
+			}
+		}
+		sort.Strings(unknown)
+		return unknown
+	}
+	return nil
+}
+
+// joinPath appends a JSON key to a field path
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,181 @@
+package http
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"reflect"
+	"testing"
+
+	"github.com/eka-care/eka-sdk-go/internal/config"
+	"github.com/eka-care/eka-sdk-go/internal/errors"
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+	"github.com/eka-care/eka-sdk-go/services/abdm/abha"
+)
+
+type decodeBase struct {
+	ID string `json:"id"`
+}
+
+type decodeNested struct {
+	Value string                     `json:"value"`
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// decodeOpaque decodes itself, so its contents are never reported
+type decodeOpaque struct {
+	raw string
+}
+
+func (o *decodeOpaque) UnmarshalJSON(data []byte) error {
+	o.raw = string(data)
+	return nil
+}
+
+type decodeResponse struct {
+	decodeBase
+	Name   string                     `json:"name"`
+	Secret string                     `json:"-"`
+	Nested *decodeNested              `json:"nested"`
+	Items  []decodeNested             `json:"items"`
+	ByKey  map[string]*decodeNested   `json:"by_key"`
+	Opaque decodeOpaque               `json:"opaque"`
+	State  abha.SkipState             `json:"skip_state"`
+	Extra  map[string]json.RawMessage `json:"-"`
+}
+
+func TestCaptureExtra(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		body    string
+		unknown []string
+		check   func(t *testing.T, v *decodeResponse)
+	}{
+		{
+			name: "known fields",
+			body: `{"id":"1","name":"a","skip_state":"abha_end"}`,
+			check: func(t *testing.T, v *decodeResponse) {
+				if v.ID != "1" || v.Extra != nil {
+					t.Errorf("ID, Extra = %q, %v", v.ID, v.Extra)
+				}
+			},
+		},
+		{
+			name: "keys match case-insensitively",
+			body: `{"ID":"1","Name":"a"}`,
+			check: func(t *testing.T, v *decodeResponse) {
+				if v.Name != "a" {
+					t.Errorf("Name = %q", v.Name)
+				}
+			},
+		},
+		{
+			name:    "unknown top-level field",
+			body:    `{"name":"a","blood_group":"O+"}`,
+			unknown: []string{"blood_group"},
+			check: func(t *testing.T, v *decodeResponse) {
+				if string(v.Extra["blood_group"]) != `"O+"` {
+					t.Errorf("Extra = %s", v.Extra)
+				}
+			},
+		},
+		{
+			name:    "fields tagged - are unknown",
+			body:    `{"Secret":"s","Extra":{}}`,
+			unknown: []string{"Extra", "Secret"},
+			check: func(t *testing.T, v *decodeResponse) {
+				if v.Secret != "" || len(v.Extra) != 2 {
+					t.Errorf("Secret, Extra = %q, %s", v.Secret, v.Extra)
+				}
+			},
+		},
+		{
+			name:    "nested struct",
+			body:    `{"nested":{"value":"v","added":1}}`,
+			unknown: []string{"nested.added"},
+			check: func(t *testing.T, v *decodeResponse) {
+				if v.Nested.Value != "v" || string(v.Nested.Extra["added"]) != "1" || v.Extra != nil {
+					t.Errorf("Nested, Extra = %+v, %s", v.Nested, v.Extra)
+				}
+			},
+		},
+		{
+			name:    "slice elements",
+			body:    `{"items":[{"value":"a"},{"value":"b","added":true}]}`,
+			unknown: []string{"items[1].added"},
+			check: func(t *testing.T, v *decodeResponse) {
+				if v.Items[0].Extra != nil || string(v.Items[1].Extra["added"]) != "true" {
+					t.Errorf("Items = %+v", v.Items)
+				}
+			},
+		},
+		{
+			name:    "map values",
+			body:    `{"by_key":{"k":{"value":"a","added":2}}}`,
+			unknown: []string{"by_key.k.added"},
+			check: func(t *testing.T, v *decodeResponse) {
+				if string(v.ByKey["k"].Extra["added"]) != "2" {
+					t.Errorf("ByKey = %+v", v.ByKey["k"])
+				}
+			},
+		},
+		{
+			name: "unmarshalers are skipped",
+			body: `{"opaque":{"anything":1}}`,
+			check: func(t *testing.T, v *decodeResponse) {
+				if v.Opaque.raw != `{"anything":1}` {
+					t.Errorf("Opaque = %q", v.Opaque.raw)
+				}
+			},
+		},
+		{
+			name: "unknown skip state",
+			body: `{"skip_state":"abha_link_aadhaar"}`,
+			check: func(t *testing.T, v *decodeResponse) {
+				if v.State != "abha_link_aadhaar" || v.State.IsKnown() || v.State.NextAction() != abha.ActionUnknown {
+					t.Errorf("State = %q, known %v, action %s", v.State, v.State.IsKnown(), v.State.NextAction())
+				}
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var v decodeResponse
+			if err := json.Unmarshal([]byte(tt.body), &v); err != nil {
+				t.Fatal(err)
+			}
+			unknown := captureExtra(reflect.ValueOf(&v), json.RawMessage(tt.body), "")
+			if !reflect.DeepEqual(unknown, tt.unknown) {
+				t.Errorf("unknown = %q, want %q", unknown, tt.unknown)
+			}
+			tt.check(t, &v)
+		})
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	body := []byte(`{"name":"a","nested":{"added":1},"blood_group":"O+","skip_state":"abha_link_aadhaar"}`)
+	for _, strict := range []bool{false, true} {
+		cfg := config.NewConfig()
+		cfg.StrictDecoding = strict
+		c := NewClientFromInterface(cfg)
+
+		var v decodeResponse
+		err := c.UnmarshalResponse(&interfaces.HTTPResponse{Body: body}, &v)
+		if !strict {
+			if err != nil || string(v.Extra["blood_group"]) != `"O+"` {
+				t.Errorf("lenient: err = %v, Extra = %s", err, v.Extra)
+			}
+			continue
+		}
+
+		var unknownErr *errors.UnknownFieldsError
+		if !stderrors.As(err, &unknownErr) || !stderrors.Is(err, errors.ErrUnknownFields) {
+			t.Fatalf("strict: err = %v, want *UnknownFieldsError", err)
+		}
+		if want := []string{"blood_group", "nested.added"}; !reflect.DeepEqual(unknownErr.Fields, want) {
+			t.Errorf("Fields = %q, want %q", unknownErr.Fields, want)
+		}
+		if want := "response has unknown fields: blood_group, nested.added"; err.Error() != want {
+			t.Errorf("Error() = %q, want %q", err, want)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// requestStart calls the OnRequestStart hook
+func (c *Client) requestStart(ctx context.Context, req *interfaces.HTTPRequest, headers interfaces.Headers, requestID string) {
+	if c.hooks.OnRequestStart == nil {
+		return
+	}
+	c.hooks.OnRequestStart(ctx, interfaces.RequestStartEvent{
+		Operation: req.Operation,
+		Method:    req.Method,
+		Path:      req.Path,
+		RequestID: requestID,
+		Patient:   headers,
+	})
+}
+
+// response calls the OnResponse hook
+func (c *Client) response(ctx context.Context, req *interfaces.HTTPRequest, headers interfaces.Headers, md interfaces.ResponseMetadata, err error) {
+	c.hooks.OnResponse(ctx, interfaces.ResponseEvent{
+		Operation:  req.Operation,
+		Method:     req.Method,
+		Path:       req.Path,
+		RequestID:  md.RequestID,
+		Patient:    headers,
+		StatusCode: md.StatusCode,
+		Latency:    md.Latency,
+		Attempts:   md.Attempts,
+		Err:        err,
+	})
+}
+
+// skipState calls the OnSkipState hook if the response body carries a skip_state
+func (c *Client) skipState(ctx context.Context, req *interfaces.HTTPRequest, headers interfaces.Headers, body []byte) {
+	var v struct {
+		SkipState string `json:"skip_state"`
+		TxnID     string `json:"txn_id"`
+	}
+	if err := json.Unmarshal(body, &v); err != nil || v.SkipState == "" {
+		return
+	}
+	c.hooks.OnSkipState(ctx, interfaces.SkipStateEvent{
+		Operation: req.Operation,
+		SkipState: v.SkipState,
+		TxnID:     v.TxnID,
+		Patient:   headers,
+	})
+}
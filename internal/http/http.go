@@ -8,19 +8,45 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/eka-care/eka-sdk-go/internal/errors"
 	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+	"github.com/eka-care/eka-sdk-go/internal/middleware"
+	"github.com/eka-care/eka-sdk-go/internal/tracing"
 )
 
-// Client represents the HTTP client
+// Client represents the HTTP client.
+//
+// A Client owns one middleware chain composed over a single base transport, so
+// connections are pooled and reused across every service that shares it. The
+// chain is composed once and recomposed only when middleware is added.
 type Client struct {
-	baseURL    string
-	apiKey     string
-	userAgent  string
-	timeout    time.Duration
-	httpClient *http.Client
+	config    interfaces.Config
+	baseURL   string
+	apiKey    string
+	userAgent string
+	timeout   time.Duration
+	transport http.RoundTripper // base transport holding the connection pool
+	limiter   *middleware.RateLimiter
+	breaker   *middleware.CircuitBreaker
+	tracer    *tracing.Tracer
+	metrics   interfaces.MetricsCollector
+	hooks     *interfaces.Hooks
+	audit     interfaces.AuditSink
+
+	// transportMiddleware wraps the base transport below the attempt counter
+	transportMiddleware []interfaces.Middleware
+
+	mu         sync.Mutex
 	middleware []interfaces.Middleware
+	httpClient atomic.Pointer[http.Client] // pre-composed chain used by Do
 }
 
 // Config represents HTTP client configuration
@@ -34,49 +60,247 @@ type Config struct {
 
 // NewClient creates a new HTTP client
 func NewClient(cfg *Config) *Client {
-	httpClient := cfg.HTTPClient
-	if httpClient == nil {
-		httpClient = &http.Client{
-			Timeout: cfg.Timeout,
-		}
+	c := &Client{
+		baseURL:   cfg.BaseURL,
+		apiKey:    cfg.APIKey,
+		userAgent: cfg.UserAgent,
+		timeout:   cfg.Timeout,
+		transport: baseTransport(cfg.HTTPClient),
+	}
+	c.compose()
+	return c
+}
+
+// NewClientFromInterface creates a new HTTP client from an interface.
+// The authorization token is read from the config on every request, so a
+// single client stays valid across logins.
+func NewClientFromInterface(config interfaces.Config) *Client {
+	return newClientFromInterface(config, nil)
+}
+
+// Derive creates a client for config that shares the rate limiter and
+// circuit breaker of c; rate limits and circuit breaker settings in config
+// are ignored. The base transport, and so the connection pool, is taken from
+// the HTTP client of config, so it is shared with c only if config keeps the
+// HTTP client of c.
+func (c *Client) Derive(config interfaces.Config) *Client {
+	return newClientFromInterface(config, c)
+}
+
+// newClientFromInterface creates a client for config, sharing the rate
+// limiter and circuit breaker of parent if it is not nil
+func newClientFromInterface(config interfaces.Config, parent *Client) *Client {
+	// Transport middleware sees every attempt as if it were the network, so
+	// the failures it injects are counted as attempts, logged, retried and
+	// counted by the breaker
+	c := &Client{
+		config:              config,
+		baseURL:             config.GetBaseURL(),
+		userAgent:           config.GetUserAgent(),
+		timeout:             config.GetTimeout(),
+		transport:           baseTransport(config.GetHTTPClient()),
+		transportMiddleware: config.GetTransportMiddleware(),
+		metrics:             config.GetMetricsCollector(),
+		hooks:               config.GetHooks(),
+		audit:               config.GetAuditSink(),
+	}
+	if limits := config.GetRateLimits(); len(limits) > 0 {
+		c.limiter = middleware.NewRateLimiter(limits)
 	}
+	if cbConfig := config.GetCircuitBreaker(); cbConfig != nil {
+		c.breaker = middleware.NewCircuitBreaker(*cbConfig, c.metrics)
+	}
+	if parent != nil {
+		c.limiter = parent.limiter
+		c.breaker = parent.breaker
+	}
+	c.compose()
+
+	// Logging sits closest to the network so that every attempt is logged
+	if logger := config.GetLogger(); logger != nil {
+		c.AddMiddleware(middleware.SlogMiddleware(logger, config.GetLogBodies()))
+	}
+	// Attempt spans wrap the logger so that logs carry the span context
+	if provider := config.GetTracerProvider(); provider != nil {
+		c.tracer = tracing.New(provider)
+		c.AddMiddleware(c.tracer.Middleware())
+	}
+	// Rate limiting sits below retries so that every attempt takes a token
+	if c.limiter != nil {
+		c.AddMiddleware(c.limiter.Middleware())
+	}
+	if maxRetries := config.GetMaxRetries(); config.GetEnableRetries() && maxRetries > 0 {
+		c.AddMiddleware(middleware.RetryMiddleware(maxRetries, defaultRetryBackoff, c.onRetry))
+	}
+	// The circuit breaker sees the outcome of a call after retries and
+	// fails fast without retrying while open
+	if c.breaker != nil {
+		c.AddMiddleware(c.breaker.Middleware())
+	}
+	// Metrics are recorded once per call
+	if c.metrics != nil {
+		c.AddMiddleware(middleware.MetricsMiddleware(c.metrics))
+	}
+	return c
+}
 
-	return &Client{
-		baseURL:    cfg.BaseURL,
-		apiKey:     cfg.APIKey,
-		userAgent:  cfg.UserAgent,
-		timeout:    cfg.Timeout,
-		httpClient: httpClient,
+// recordErrorCode reports an API error code, or the HTTP status when the
+// gateway sent none, to the metrics collector
+func (c *Client) recordErrorCode(ctx context.Context, code string) {
+	if m, ok := c.metrics.(interfaces.ErrorCodeMetrics); ok {
+		m.RecordErrorCode(interfaces.OperationFromContext(ctx), code)
 	}
 }
 
-// NewClientFromInterface creates a new HTTP client from an interface
-func NewClientFromInterface(config interfaces.Config) *Client {
-	httpClient := config.GetHTTPClient()
-	if httpClient == nil {
-		httpClient = &http.Client{
-			Timeout: config.GetTimeout(),
+// onRetry reports a retry to the metrics collector and the OnRetry hook
+func (c *Client) onRetry(req *http.Request, attempt int, resp *http.Response, err error, delay time.Duration) {
+	ctx := req.Context()
+	operation := interfaces.OperationFromContext(ctx)
+
+	if m, ok := c.metrics.(interfaces.RetryMetrics); ok {
+		m.RecordRetry(operation, attempt)
+	}
+	if c.hooks != nil && c.hooks.OnRetry != nil {
+		event := interfaces.RetryEvent{
+			Operation: operation,
+			Method:    req.Method,
+			Path:      req.URL.Path,
+			Attempt:   attempt,
+			Err:       err,
+			Delay:     delay,
+		}
+		if resp != nil {
+			event.StatusCode = resp.StatusCode
 		}
+		c.hooks.OnRetry(ctx, event)
+	}
+}
+
+// CircuitStates returns the state of every circuit keyed by host and endpoint
+// group, or nil if the circuit breaker is disabled
+func (c *Client) CircuitStates() map[string]interfaces.CircuitState {
+	if c.breaker == nil {
+		return nil
 	}
+	return c.breaker.States()
+}
+
+// defaultRetryBackoff is the base delay between retry attempts
+const defaultRetryBackoff = 500 * time.Millisecond
 
-	return &Client{
-		baseURL:    config.GetBaseURL(),
-		apiKey:     config.GetAPIKey(),
-		userAgent:  config.GetUserAgent(),
-		timeout:    config.GetTimeout(),
-		httpClient: httpClient,
+// baseTransport returns the transport of the user supplied client, or the
+// shared default transport
+func baseTransport(httpClient *http.Client) http.RoundTripper {
+	if httpClient != nil && httpClient.Transport != nil {
+		return httpClient.Transport
 	}
+	return http.DefaultTransport
 }
 
-// AddMiddleware adds middleware to the client
+// AddMiddleware adds middleware to the client. Middleware added first runs
+// closest to the network.
 func (c *Client) AddMiddleware(middleware interfaces.Middleware) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.middleware = append(c.middleware, middleware)
+	c.compose()
+}
+
+// compose builds the middleware chain over the base transport; callers must
+// hold c.mu or own c exclusively
+func (c *Client) compose() {
+	transport := c.transport
+	for _, mw := range c.transportMiddleware {
+		transport = mw(transport)
+	}
+	transport = &attemptTransport{next: transport}
+	for _, mw := range c.middleware {
+		transport = mw(transport)
+	}
+
+	c.httpClient.Store(&http.Client{
+		Transport: transport,
+		Timeout:   c.timeout,
+	})
+}
+
+// token returns the authorization token to send
+func (c *Client) token() string {
+	if c.config != nil {
+		return c.config.GetAPIKey()
+	}
+	return c.apiKey
 }
 
-// Do performs an HTTP request
+// Do performs an HTTP request for a service operation
 func (c *Client) Do(ctx context.Context, req *interfaces.HTTPRequest) (*interfaces.HTTPResponse, error) {
+	if req.Operation != "" {
+		ctx = interfaces.ContextWithOperation(ctx, req.Operation)
+	}
+
+	if c.tracer == nil {
+		return c.do(ctx, req)
+	}
+
+	ctx, span := c.tracer.Start(ctx, req.Operation)
+	resp, err := c.do(ctx, req)
+	c.tracer.End(span, resp, err)
+	return resp, err
+}
+
+// do performs an HTTP request
+func (c *Client) do(ctx context.Context, req *interfaces.HTTPRequest) (_ *interfaces.HTTPResponse, err error) {
+	// Explicit headers take precedence over those carried by the context,
+	// which take precedence over the client's defaults
+	headers := req.Headers
+	if ctxHeaders, ok := interfaces.HeadersFromContext(ctx); ok {
+		headers = headers.Merge(ctxHeaders)
+	}
+	if c.config != nil {
+		headers = headers.Merge(c.config.GetDefaultHeaders())
+	}
+	opts := interfaces.ApplyRequestOptions(req.Options)
+
+	// Every call, including those rejected below, is reported to the hooks
+	// and the audit sink. They get the caller's context, as the timeout
+	// context is cancelled before they run.
+	var md interfaces.ResponseMetadata
+	if opts.Metadata != nil {
+		defer func() { *opts.Metadata = md }()
+	}
+	callCtx := ctx
+	if c.audit != nil && isABDMOperation(req.Operation) {
+		defer func() { c.recordAudit(callCtx, req, headers, md, err) }()
+	}
+	if c.hooks != nil {
+		c.requestStart(callCtx, req, headers, opts.RequestID)
+		if c.hooks.OnResponse != nil {
+			defer func() { c.response(callCtx, req, headers, md, err) }()
+		}
+	}
+
+	if c.config != nil && c.config.IsClosed() {
+		return nil, errors.ErrClientClosed
+	}
+	if c.config != nil && c.config.GetStrictPatientContext() && isABDMPath(req.Path) && headers.IsZero() {
+		return nil, errors.ErrMissingPatientContext
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	ctx = interfaces.ContextWithCaller(ctx)
+
+	baseURL := c.baseURL
+	if opts.BaseURL != "" {
+		baseURL = opts.BaseURL
+	}
+
 	// Build URL
-	u, err := url.Parse(c.baseURL + req.Path)
+	u, err := url.Parse(baseURL + req.Path)
 	if err != nil {
 		return nil, fmt.Errorf("invalid URL: %w", err)
 	}
@@ -102,53 +326,74 @@ func (c *Client) Do(ctx context.Context, req *interfaces.HTTPRequest) (*interfac
 		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
+	// Count the attempts made by the middleware chain for this call
+	ctx, attempts := interfaces.ContextWithAttemptCounter(ctx)
+
 	// Create HTTP request
 	httpReq, err := http.NewRequestWithContext(ctx, req.Method, u.String(), reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Set headers
-	httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+	// Set headers. Client-wide headers go first so that they cannot replace
+	// the authorization, content type and user agent set by the SDK.
+	if c.config != nil {
+		for key, values := range c.config.GetHeader() {
+			// Copied so that middleware adding values cannot modify the config
+			httpReq.Header[http.CanonicalHeaderKey(key)] = slices.Clone(values)
+		}
+	}
+	if token := c.token(); token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+token)
+	}
 	httpReq.Header.Set("Content-Type", "application/json")
 	httpReq.Header.Set("User-Agent", c.userAgent)
 
-	if req.Headers.PatientID != "" {
-		httpReq.Header.Set("X-Pt-Id", req.Headers.PatientID)
+	if headers.PatientID != "" {
+		httpReq.Header.Set("X-Pt-Id", headers.PatientID)
 	}
-	if req.Headers.PartnerUserID != "" {
-		httpReq.Header.Set("X-Partner-Pt-Id", req.Headers.PartnerUserID)
+	if headers.PartnerUserID != "" {
+		httpReq.Header.Set("X-Partner-Pt-Id", headers.PartnerUserID)
 	}
-	if req.Headers.HipID != "" {
-		httpReq.Header.Set("X-Hip-Id", req.Headers.HipID)
+	if headers.HipID != "" {
+		httpReq.Header.Set("X-Hip-Id", headers.HipID)
 	}
-
-	// Apply middleware
-	transport := c.httpClient.Transport
-	if transport == nil {
-		transport = http.DefaultTransport
+	if opts.RequestID != "" {
+		httpReq.Header.Set("X-Request-Id", opts.RequestID)
 	}
-
-	// Apply custom middleware
-	for _, mw := range c.middleware {
-		transport = mw(transport)
+	if opts.IdempotencyKey != "" {
+		httpReq.Header.Set("Idempotency-Key", opts.IdempotencyKey)
 	}
-
-	// Create client with custom transport
-	client := &http.Client{
-		Transport: transport,
-		Timeout:   c.timeout,
+	for key, values := range opts.Header {
+		httpReq.Header[key] = slices.Clone(values)
 	}
 
+	client := c.httpClient.Load()
+
+	start := time.Now()
+
 	// Make the request
 	resp, err := client.Do(httpReq)
 	if err != nil {
+		md = interfaces.ResponseMetadata{
+			RequestID: opts.RequestID,
+			Latency:   time.Since(start),
+			Attempts:  int(attempts.Load()),
+		}
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Read response body
 	respBody, err := io.ReadAll(resp.Body)
+	md = interfaces.ResponseMetadata{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		RequestID:  requestID(resp, opts.RequestID),
+		Latency:    time.Since(start),
+		Attempts:   int(attempts.Load()),
+		Body:       respBody,
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
@@ -157,27 +402,56 @@ func (c *Client) Do(ctx context.Context, req *interfaces.HTTPRequest) (*interfac
 	if resp.StatusCode >= 400 {
 		var errorResp ErrorResponse
 		if err := json.Unmarshal(respBody, &errorResp); err != nil {
+			c.recordErrorCode(ctx, strconv.Itoa(resp.StatusCode))
 			return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody))
 		}
-		return nil, &APIError{
+		apiErr := &APIError{
 			Code:    resp.StatusCode,
 			Message: errorResp.String(),
 		}
+		if errorResp.SourceError != nil {
+			apiErr.SourceCode = errorResp.SourceError.Code
+		}
+		if apiErr.SourceCode != "" {
+			c.recordErrorCode(ctx, apiErr.SourceCode)
+		} else {
+			c.recordErrorCode(ctx, strconv.Itoa(apiErr.Code))
+		}
+		return nil, apiErr
+	}
+
+	if c.hooks != nil && c.hooks.OnSkipState != nil {
+		c.skipState(ctx, req, headers, respBody)
 	}
 
 	return &interfaces.HTTPResponse{
 		StatusCode: resp.StatusCode,
 		Body:       respBody,
 		Headers:    resp.Header,
+		Patient:    headers,
 	}, nil
 }
 
-// UnmarshalResponse unmarshals the response body into the given type
+// isABDMPath returns true for ABDM endpoints, which operate on a patient
+func isABDMPath(path string) bool {
+	return strings.HasPrefix(path, "/abdm/")
+}
+
+// UnmarshalResponse unmarshals the response body into the given type. JSON
+// fields the type has no field for are kept in the Extra field of the
+// enclosing struct, if it has one, and fail the call in strict decoding mode.
 func (c *Client) UnmarshalResponse(resp *interfaces.HTTPResponse, v interface{}) error {
 	if len(resp.Body) == 0 {
 		return nil
 	}
-	return json.Unmarshal(resp.Body, v)
+	if err := json.Unmarshal(resp.Body, v); err != nil {
+		return err
+	}
+	unknown := captureExtra(reflect.ValueOf(v), resp.Body, "")
+	if len(unknown) > 0 && c.config != nil && c.config.GetStrictDecoding() {
+		return &errors.UnknownFieldsError{Fields: unknown}
+	}
+	return nil
 }
 
 // ErrorResponse represents an API error response
@@ -199,10 +473,21 @@ func (e *ErrorResponse) String() string {
 
 // APIError represents an API error
 type APIError struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+	Code       int    `json:"code"`
+	Message    string `json:"message"`
+	SourceCode string `json:"source_code,omitempty"` // ABDM error code, when the gateway reported one
 }
 
 func (e *APIError) Error() string {
 	return e.Message
 }
+
+// StatusCode returns the HTTP status code of the error response
+func (e *APIError) StatusCode() int {
+	return e.Code
+}
+
+// SourceErrorCode returns the ABDM error code, or "" if none was reported
+func (e *APIError) SourceErrorCode() string {
+	return e.SourceCode
+}
@@ -0,0 +1,285 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/eka-care/eka-sdk-go/internal/config"
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// newTestClient returns a client for srv authorised with token
+func newTestClient(t testing.TB, srv *httptest.Server, token string) (*Client, *config.Config) {
+	t.Helper()
+	cfg := config.NewConfig()
+	cfg.BaseURL = srv.URL
+	cfg.HTTPClient = srv.Client()
+	cfg.Session.SetToken(token)
+	return NewClientFromInterface(cfg), cfg
+}
+
+func TestClientHeadersCannotReplaceSDKHeaders(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	}))
+	defer srv.Close()
+
+	c, cfg := newTestClient(t, srv, "token-1")
+	cfg.Header = http.Header{
+		"authorization": {"Bearer other"},
+		"Content-Type":  {"text/plain"},
+		"User-Agent":    {"custom"},
+		"X-Clinic-Id":   {"c-1"},
+	}
+
+	if _, err := c.Do(context.Background(), &interfaces.HTTPRequest{Method: http.MethodGet, Path: "/ping"}); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	for key, want := range map[string]string{
+		"Authorization": "Bearer token-1",
+		"Content-Type":  "application/json",
+		"User-Agent":    cfg.UserAgent,
+		"X-Clinic-Id":   "c-1",
+	} {
+		if values := got.Values(key); len(values) != 1 || values[0] != want {
+			t.Errorf("%s = %q, want %q", key, values, want)
+		}
+	}
+}
+
+func TestMiddlewareCannotModifyClientHeaders(t *testing.T) {
+	srv := okServer()
+	defer srv.Close()
+
+	c, cfg := newTestClient(t, srv, "token-1")
+	cfg.Header = http.Header{"X-Clinic-Id": make([]string, 1, 4)}
+	cfg.Header["X-Clinic-Id"][0] = "c-1"
+	c.AddMiddleware(func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Add("X-Clinic-Id", "added")
+			return next.RoundTrip(req)
+		})
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Do(context.Background(), &interfaces.HTTPRequest{Method: http.MethodGet, Path: "/ping"}); err != nil {
+			t.Fatalf("Do: %v", err)
+		}
+	}
+	if values := cfg.Header["X-Clinic-Id"]; len(values) != 1 || values[:2][1] != "" {
+		t.Errorf("config header = %q, backing array %q; want it unchanged", values, values[:2])
+	}
+}
+
+func TestDeriveUsesTransportOfConfig(t *testing.T) {
+	srv := okServer()
+	defer srv.Close()
+
+	c, cfg := newTestClient(t, srv, "token-1")
+	same := *cfg
+	if d := c.Derive(&same); d.transport != c.transport {
+		t.Error("derived client with the same HTTP client has a different transport")
+	}
+
+	other := *cfg
+	other.HTTPClient = &http.Client{Transport: &http.Transport{}}
+	if d := c.Derive(&other); d.transport != other.HTTPClient.Transport {
+		t.Error("derived client does not use the transport of its HTTP client")
+	}
+}
+
+// connCounter counts the connections used by requests made with its context
+type connCounter struct {
+	reused, created atomic.Int64
+}
+
+func (cc *connCounter) context(ctx context.Context) context.Context {
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			if info.Reused {
+				cc.reused.Add(1)
+			} else {
+				cc.created.Add(1)
+			}
+		},
+	})
+}
+
+func okServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"txn_id":"t-1"}`))
+	}))
+}
+
+func TestConnectionReuse(t *testing.T) {
+	srv := okServer()
+	defer srv.Close()
+
+	c, cfg := newTestClient(t, srv, "token")
+	// A derived client, as used by Client.With, shares the connection pool
+	derivedCfg := *cfg
+	derived := c.Derive(&derivedCfg)
+
+	var cc connCounter
+	ctx := cc.context(context.Background())
+	const calls = 20
+	for i := 0; i < calls; i++ {
+		client := c
+		if i%2 == 1 {
+			client = derived
+		}
+		if _, err := client.Do(ctx, &interfaces.HTTPRequest{Method: http.MethodGet, Path: "/ping"}); err != nil {
+			t.Fatalf("Do: %v", err)
+		}
+	}
+	if created, reused := cc.created.Load(), cc.reused.Load(); created != 1 || reused != calls-1 {
+		t.Errorf("connections created = %d, reused = %d; want 1 and %d", created, reused, calls-1)
+	}
+}
+
+func TestRetriesAreOptIn(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		retry bool
+		want  int64
+	}{
+		{name: "default", want: 1},
+		{name: "enabled", retry: true, want: 2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int64
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer srv.Close()
+
+			cfg := config.NewConfig()
+			cfg.BaseURL = srv.URL
+			cfg.HTTPClient = srv.Client()
+			cfg.MaxRetries = 1
+			cfg.EnableRetries = tt.retry
+			c := NewClientFromInterface(cfg)
+
+			if _, err := c.Do(context.Background(), &interfaces.HTTPRequest{Method: http.MethodGet, Path: "/ping"}); err == nil {
+				t.Fatal("Do succeeded, want HTTP 503 error")
+			}
+			if got := requests.Load(); got != tt.want {
+				t.Errorf("server saw %d requests, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// BenchmarkClientDo reports the allocations of a call through the shared
+// transport chain and the connections it opens per call
+func BenchmarkClientDo(b *testing.B) {
+	srv := okServer()
+	defer srv.Close()
+	c, _ := newTestClient(b, srv, "token")
+
+	var cc connCounter
+	ctx := cc.context(context.Background())
+	req := &interfaces.HTTPRequest{Method: http.MethodPost, Path: "/abdm/na/v1/registration/aadhaar/init", Body: map[string]string{"aadhaar_number": "123412341234"}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.Do(ctx, req); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(cc.created.Load())/float64(b.N), "conns/op")
+}
+
+// BenchmarkClientDoParallel is BenchmarkClientDo with concurrent callers
+// sharing one client
+func BenchmarkClientDoParallel(b *testing.B) {
+	srv := okServer()
+	defer srv.Close()
+	c, _ := newTestClient(b, srv, "token")
+
+	var cc connCounter
+	ctx := cc.context(context.Background())
+	req := &interfaces.HTTPRequest{Method: http.MethodGet, Path: "/abdm/v1/profile"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := c.Do(ctx, req); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+	b.ReportMetric(float64(cc.created.Load())/float64(b.N), "conns/op")
+}
+
+func TestAttemptsFailedByTransportMiddlewareAreNumbered(t *testing.T) {
+	srv := okServer()
+	defer srv.Close()
+
+	var logs bytes.Buffer
+	var calls atomic.Int64
+	cfg := config.NewConfig()
+	cfg.BaseURL = srv.URL
+	cfg.HTTPClient = srv.Client()
+	cfg.EnableRetries = true
+	cfg.MaxRetries = 1
+	cfg.Logger = slog.New(slog.NewJSONHandler(&logs, nil))
+	// Fails the first attempt without reaching the network, like a fault
+	// injected with the chaos package
+	cfg.TransportMiddleware = []interfaces.Middleware{func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if calls.Add(1) == 1 {
+				return &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Body:       io.NopCloser(strings.NewReader("{}")),
+					Request:    req,
+				}, nil
+			}
+			return next.RoundTrip(req)
+		})
+	}}
+	c := NewClientFromInterface(cfg)
+
+	var md interfaces.ResponseMetadata
+	req := &interfaces.HTTPRequest{Method: http.MethodGet, Path: "/ping", Options: []interfaces.RequestOption{
+		func(o *interfaces.RequestOptions) { o.Metadata = &md },
+	}}
+	if _, err := c.Do(context.Background(), req); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	var attempts []int
+	for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+		var entry struct{ Attempt int }
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("log line %q: %v", line, err)
+		}
+		attempts = append(attempts, entry.Attempt)
+	}
+	if len(attempts) != 2 || attempts[0] != 1 || attempts[1] != 2 {
+		t.Errorf("logged attempts = %v, want [1 2]", attempts)
+	}
+	if md.Attempts != 2 {
+		t.Errorf("metadata attempts = %d, want 2", md.Attempts)
+	}
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
@@ -0,0 +1,31 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// attemptTransport counts attempts; it sits below the middleware chain so
+// that retries performed by middleware are counted individually, and above
+// transport middleware so that attempts it fails are counted too
+type attemptTransport struct {
+	next http.RoundTripper
+}
+
+func (a *attemptTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if counter, ok := interfaces.AttemptCounterFromContext(req.Context()); ok {
+		counter.Add(1)
+	}
+	return a.next.RoundTrip(req)
+}
+
+// requestID returns the request ID echoed by the server, falling back to the one sent
+func requestID(resp *http.Response, sent string) string {
+	if resp != nil {
+		if id := resp.Header.Get("X-Request-Id"); id != "" {
+			return id
+		}
+	}
+	return sent
+}
@@ -0,0 +1,40 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// AuditSink receives an audit entry for every ABDM call. Record is called
+// synchronously once the call completes; a returned error is logged and does
+// not fail the call.
+type AuditSink interface {
+	Record(ctx context.Context, entry AuditEntry) error
+}
+
+// AuditOutcome is the result of an audited call
+type AuditOutcome string
+
+const (
+	// AuditOutcomeSuccess is recorded when the gateway accepted the call
+	AuditOutcomeSuccess AuditOutcome = "success"
+	// AuditOutcomeFailure is recorded when the gateway rejected the call
+	AuditOutcomeFailure AuditOutcome = "failure"
+	// AuditOutcomeError is recorded when no response was received
+	AuditOutcomeError AuditOutcome = "error"
+)
+
+// AuditEntry records who accessed or modified which patient's ABHA data. It
+// never carries OTPs, Aadhaar numbers, tokens or request bodies.
+type AuditEntry struct {
+	Timestamp     time.Time    `json:"timestamp"`
+	Operation     string       `json:"operation"`
+	ClientID      string       `json:"client_id,omitempty"`
+	PartnerUserID string       `json:"partner_user_id,omitempty"`
+	HipID         string       `json:"hip_id,omitempty"`
+	PatientOID    string       `json:"patient_oid,omitempty"`
+	Outcome       AuditOutcome `json:"outcome"`
+	StatusCode    int          `json:"status_code,omitempty"`
+	ErrorCode     string       `json:"error_code,omitempty"`
+	RequestID     string       `json:"request_id,omitempty"`
+}
@@ -0,0 +1,65 @@
+package interfaces
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// attemptsContextKey is the context key for the per-call attempt counter
+type attemptsContextKey struct{}
+
+// ContextWithAttemptCounter returns a context carrying a counter of the
+// attempts sent on the wire for one call
+func ContextWithAttemptCounter(ctx context.Context) (context.Context, *atomic.Int32) {
+	counter := &atomic.Int32{}
+	return context.WithValue(ctx, attemptsContextKey{}, counter), counter
+}
+
+// AttemptCounterFromContext returns the attempt counter of the call, if any
+func AttemptCounterFromContext(ctx context.Context) (*atomic.Int32, bool) {
+	counter, ok := ctx.Value(attemptsContextKey{}).(*atomic.Int32)
+	return counter, ok
+}
+
+// AttemptFromContext returns the number of the attempt in flight, starting
+// at 1, or 0 if the context carries no counter
+func AttemptFromContext(ctx context.Context) int {
+	if counter, ok := AttemptCounterFromContext(ctx); ok {
+		return int(counter.Load())
+	}
+	return 0
+}
+
+// operationContextKey is the context key for the service operation name
+type operationContextKey struct{}
+
+// ContextWithOperation returns a context carrying the name of the service
+// operation, e.g. abdm.registration.AadhaarVerify
+func ContextWithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, operation)
+}
+
+// OperationFromContext returns the name of the service operation, or "" if unknown
+func OperationFromContext(ctx context.Context) string {
+	operation, _ := ctx.Value(operationContextKey{}).(string)
+	return operation
+}
+
+// callerContextKey is the context key for the context passed in by the caller
+type callerContextKey struct{}
+
+// ContextWithCaller marks ctx as the caller's context of a call, so that
+// middleware can tell the caller's cancellation and deadlines apart from the
+// HTTP client's own timeout
+func ContextWithCaller(ctx context.Context) context.Context {
+	return context.WithValue(ctx, callerContextKey{}, ctx)
+}
+
+// CallerContext returns the caller's context of a call, or ctx itself if it
+// was not marked with ContextWithCaller
+func CallerContext(ctx context.Context) context.Context {
+	if caller, ok := ctx.Value(callerContextKey{}).(context.Context); ok {
+		return caller
+	}
+	return ctx
+}
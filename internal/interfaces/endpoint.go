@@ -0,0 +1,75 @@
+package interfaces
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// EndpointGroup groups API endpoints that share rate limits and failure behaviour
+type EndpointGroup string
+
+const (
+	// EndpointGroupAuth covers client login, refresh and logout
+	EndpointGroupAuth EndpointGroup = "auth"
+	// EndpointGroupOTP covers every call that makes ABDM send an OTP
+	EndpointGroupOTP EndpointGroup = "otp"
+	// EndpointGroupProfileRead covers profile, card and QR reads
+	EndpointGroupProfileRead EndpointGroup = "profile_read"
+	// EndpointGroupRegistrationWrite covers registration calls that create or verify data
+	EndpointGroupRegistrationWrite EndpointGroup = "registration_write"
+	// EndpointGroupDefault covers every other endpoint
+	EndpointGroupDefault EndpointGroup = "default"
+)
+
+// ClassifyEndpoint returns the group of the endpoint with the given method and path
+func ClassifyEndpoint(method, path string) EndpointGroup {
+	switch {
+	case strings.HasPrefix(path, "/connect-auth/"):
+		return EndpointGroupAuth
+	case strings.HasPrefix(path, "/abdm/") && (strings.HasSuffix(path, "/init") || strings.HasSuffix(path, "/resend")):
+		return EndpointGroupOTP
+	case method == http.MethodGet && strings.HasPrefix(path, "/abdm/v1/profile"):
+		return EndpointGroupProfileRead
+	case method != http.MethodGet && strings.HasPrefix(path, "/abdm/na/v1/registration/"):
+		return EndpointGroupRegistrationWrite
+	default:
+		return EndpointGroupDefault
+	}
+}
+
+// RateLimit configures the client-side token bucket of an endpoint group
+type RateLimit struct {
+	Rate  float64 // Sustained requests per second
+	Burst int     // Maximum requests sent back to back
+}
+
+// CircuitState is the state of a circuit breaker
+type CircuitState string
+
+const (
+	// CircuitClosed lets requests through and counts failures
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen fails requests fast without sending them
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen lets a limited number of probe requests through
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+// String returns the string representation of the CircuitState
+func (s CircuitState) String() string {
+	return string(s)
+}
+
+// CircuitBreakerConfig configures the circuit breaker kept per host and endpoint group
+type CircuitBreakerConfig struct {
+	FailureThreshold    int           // Consecutive 5xx responses or timeouts that open the circuit
+	OpenTimeout         time.Duration // Time spent open before probing again
+	HalfOpenMaxRequests int           // Concurrent probe requests allowed while half-open
+}
+
+// CircuitBreakerMetrics is an optional interface for MetricsCollector
+// implementations that record circuit state transitions
+type CircuitBreakerMetrics interface {
+	RecordCircuitStateChange(key string, from, to CircuitState)
+}
@@ -0,0 +1,95 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Hooks are callbacks invoked synchronously on SDK events. Every field is
+// optional. Callbacks run on the calling goroutine, so they must be fast and
+// must not call back into the client.
+type Hooks struct {
+	// OnRequestStart is called before a service call is sent
+	OnRequestStart func(ctx context.Context, event RequestStartEvent)
+	// OnResponse is called once a service call completes, after retries
+	OnResponse func(ctx context.Context, event ResponseEvent)
+	// OnRetry is called before every retry of an HTTP attempt
+	OnRetry func(ctx context.Context, event RetryEvent)
+	// OnTokenRefreshed is called after the access token is refreshed
+	OnTokenRefreshed func(ctx context.Context, event TokenRefreshedEvent)
+	// OnTokenRefreshFailed is called when refreshing the access token fails
+	OnTokenRefreshFailed func(ctx context.Context, event TokenRefreshFailedEvent)
+	// OnSkipState is called when an ABDM response carries a skip_state
+	OnSkipState func(ctx context.Context, event SkipStateEvent)
+	// OnAbhaCreated is called after an ABHA address is created
+	OnAbhaCreated func(ctx context.Context, event AbhaCreatedEvent)
+	// OnAuditError is called when the audit sink fails to record an entry
+	OnAuditError func(ctx context.Context, event AuditErrorEvent)
+}
+
+// RequestStartEvent describes a service call about to be sent
+type RequestStartEvent struct {
+	Operation string
+	Method    string
+	Path      string
+	RequestID string
+	Patient   Headers
+}
+
+// ResponseEvent describes a completed service call
+type ResponseEvent struct {
+	Operation  string
+	Method     string
+	Path       string
+	RequestID  string
+	Patient    Headers
+	StatusCode int // 0 if no response was received
+	Latency    time.Duration
+	Attempts   int
+	Err        error
+}
+
+// RetryEvent describes a retry of a failed HTTP attempt
+type RetryEvent struct {
+	Operation  string
+	Method     string
+	Path       string
+	Attempt    int // number of the attempt about to be sent, starting at 2
+	StatusCode int // status of the failed attempt, 0 if it got no response
+	Err        error
+	Delay      time.Duration
+}
+
+// TokenRefreshedEvent describes a successful access token refresh
+type TokenRefreshedEvent struct {
+	ExpiresAt time.Time
+}
+
+// TokenRefreshFailedEvent describes a failed access token refresh; the
+// provider falls back to a fresh login
+type TokenRefreshFailedEvent struct {
+	Err error
+}
+
+// SkipStateEvent reports the next step of an ABDM flow
+type SkipStateEvent struct {
+	Operation string
+	SkipState string
+	TxnID     string
+	Patient   Headers
+}
+
+// AbhaCreatedEvent describes a newly created ABHA address
+type AbhaCreatedEvent struct {
+	Operation   string
+	AbhaAddress string
+	AbhaNumber  string
+	OID         string // Eka patient OID, if returned
+	Patient     Headers
+}
+
+// AuditErrorEvent describes an audit entry the audit sink failed to record
+type AuditErrorEvent struct {
+	Entry AuditEntry
+	Err   error
+}
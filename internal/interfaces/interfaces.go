@@ -2,14 +2,18 @@ package interfaces
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Config represents the configuration interface
 type Config interface {
 	GetBaseURL() string
 	GetAPIKey() string
+	GetClientID() string
 	GetTimeout() time.Duration
 	GetMaxRetries() int
 	GetUserAgent() string
@@ -22,6 +26,21 @@ type Config interface {
 	GetRequestTimeout() time.Duration
 	GetResponseTimeout() time.Duration
 	GetConnectionTimeout() time.Duration
+	GetStrictPatientContext() bool
+	GetStrictDecoding() bool
+	GetEnableRetries() bool
+	GetRateLimits() map[EndpointGroup]RateLimit
+	GetCircuitBreaker() *CircuitBreakerConfig
+	GetLogger() *slog.Logger
+	GetLogBodies() bool
+	GetTracerProvider() trace.TracerProvider
+	GetMetricsCollector() MetricsCollector
+	GetHooks() *Hooks
+	GetAuditSink() AuditSink
+	GetDefaultHeaders() Headers
+	GetHeader() http.Header
+	GetTransportMiddleware() []Middleware
+	IsClosed() bool
 }
 
 // HTTPClient represents the HTTP client interface
@@ -33,11 +52,13 @@ type HTTPClient interface {
 
 // HTTPRequest represents an HTTP request
 type HTTPRequest struct {
-	Method  string
-	Path    string
-	Headers Headers
-	Body    interface{}
-	Params  map[string]string
+	Operation string // Name of the service operation, e.g. abdm.registration.AadhaarVerify
+	Method    string
+	Path      string
+	Headers   Headers
+	Body      interface{}
+	Params    map[string]string
+	Options   []RequestOption
 }
 
 // HTTPResponse represents an HTTP response
@@ -45,6 +66,9 @@ type HTTPResponse struct {
 	StatusCode int
 	Body       []byte
 	Headers    http.Header
+	// Patient holds the patient headers sent with the call, after merging
+	// those of the context and the client defaults
+	Patient Headers
 }
 
 // Headers represents request headers
@@ -54,6 +78,73 @@ type Headers struct {
 	HipID         string
 }
 
+// IsZero returns true if no header is set
+func (h Headers) IsZero() bool {
+	return h == Headers{}
+}
+
+// Merge returns h with every empty field filled from fallback
+func (h Headers) Merge(fallback Headers) Headers {
+	if h.PatientID == "" {
+		h.PatientID = fallback.PatientID
+	}
+	if h.PartnerUserID == "" {
+		h.PartnerUserID = fallback.PartnerUserID
+	}
+	if h.HipID == "" {
+		h.HipID = fallback.HipID
+	}
+	return h
+}
+
+// headersContextKey is the context key for patient headers
+type headersContextKey struct{}
+
+// ContextWithHeaders returns a copy of ctx carrying the given patient headers
+func ContextWithHeaders(ctx context.Context, headers Headers) context.Context {
+	return context.WithValue(ctx, headersContextKey{}, headers)
+}
+
+// HeadersFromContext returns the patient headers stored in ctx, if any
+func HeadersFromContext(ctx context.Context) (Headers, bool) {
+	headers, ok := ctx.Value(headersContextKey{}).(Headers)
+	return headers, ok
+}
+
+// RequestOption configures a single API call
+type RequestOption func(*RequestOptions)
+
+// RequestOptions holds per-call overrides applied by the HTTP client
+type RequestOptions struct {
+	Header         http.Header       // Extra headers, overriding the SDK defaults
+	Timeout        time.Duration     // Deadline for the whole call, including retries
+	RequestID      string            // Sent as X-Request-Id
+	IdempotencyKey string            // Sent as Idempotency-Key
+	BaseURL        string            // Overrides the environment base URL
+	Metadata       *ResponseMetadata // Filled with the raw response details when set
+}
+
+// ResponseMetadata describes the raw HTTP exchange behind a service call
+type ResponseMetadata struct {
+	StatusCode int           // HTTP status of the final attempt
+	Header     http.Header   // Response headers, e.g. rate-limit and deprecation headers
+	RequestID  string        // X-Request-Id returned by the server, or the one sent
+	Latency    time.Duration // Time spent on the call, including retries
+	Attempts   int           // Number of attempts sent on the wire
+	Body       []byte        // Raw response body
+}
+
+// ApplyRequestOptions builds RequestOptions from the given options
+func ApplyRequestOptions(opts []RequestOption) *RequestOptions {
+	o := &RequestOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+	return o
+}
+
 // Middleware represents a middleware function
 type Middleware func(next http.RoundTripper) http.RoundTripper
 
@@ -63,7 +154,33 @@ type Logger interface {
 	LogResponse(*http.Response, error, time.Duration)
 }
 
-// MetricsCollector represents a metrics collector interface
+// MetricsCollector represents a metrics collector interface. RecordRequest is
+// called once per service call, after retries; the operation name is
+// available from the request context through OperationFromContext.
 type MetricsCollector interface {
 	RecordRequest(*http.Request, *http.Response, error, time.Duration)
 }
+
+// RetryMetrics is an optional interface for MetricsCollector implementations
+// that count retries
+type RetryMetrics interface {
+	RecordRetry(operation string, attempt int)
+}
+
+// TokenRefreshMetrics is an optional interface for MetricsCollector
+// implementations that count access token refreshes
+type TokenRefreshMetrics interface {
+	RecordTokenRefresh(success bool)
+}
+
+// OTPMetrics is an optional interface for MetricsCollector implementations
+// that count OTPs sent by ABDM
+type OTPMetrics interface {
+	RecordOTPSend(operation string)
+}
+
+// ErrorCodeMetrics is an optional interface for MetricsCollector
+// implementations that count API errors by code
+type ErrorCodeMetrics interface {
+	RecordErrorCode(operation string, code string)
+}
@@ -0,0 +1,40 @@
+// Package logging builds the SDK's default log/slog logger from the configured log level.
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// LevelOff disables the built-in logger
+const LevelOff = "off"
+
+// ParseLevel converts a log level name ("debug", "info", "warn", "error") to a slog.Level
+func ParseLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("unknown log level %q", level)
+	}
+}
+
+// New returns a text logger writing to stderr at the given level, or nil if
+// level is empty, "off" or "none". Unknown levels fall back to info.
+func New(level string) *slog.Logger {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "", LevelOff, "none":
+		return nil
+	}
+
+	lvl, _ := ParseLevel(level)
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: lvl})).With("sdk", "eka-sdk-go")
+}
@@ -0,0 +1,244 @@
+package middleware
+
+import (
+	stderrors "errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/eka-care/eka-sdk-go/internal/errors"
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// CircuitBreaker keeps one circuit per host and endpoint group so that an
+// unavailable ABDM gateway fails fast instead of waiting for every timeout
+type CircuitBreaker struct {
+	config  interfaces.CircuitBreakerConfig
+	metrics interfaces.MetricsCollector
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+// NewCircuitBreaker creates a circuit breaker. State transitions are reported
+// to metrics if it implements interfaces.CircuitBreakerMetrics; metrics may be nil.
+func NewCircuitBreaker(config interfaces.CircuitBreakerConfig, metrics interfaces.MetricsCollector) *CircuitBreaker {
+	if config.FailureThreshold < 1 {
+		config.FailureThreshold = 1
+	}
+	if config.HalfOpenMaxRequests < 1 {
+		config.HalfOpenMaxRequests = 1
+	}
+	return &CircuitBreaker{
+		config:   config,
+		metrics:  metrics,
+		circuits: make(map[string]*circuit),
+	}
+}
+
+// Middleware returns the middleware that guards requests with the breaker
+func (b *CircuitBreaker) Middleware() interfaces.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &circuitBreakerTransport{
+			next:    next,
+			breaker: b,
+		}
+	}
+}
+
+// States returns the state of every circuit seen so far, keyed by host and endpoint group
+func (b *CircuitBreaker) States() map[string]interfaces.CircuitState {
+	b.mu.Lock()
+	keys := make([]string, 0, len(b.circuits))
+	circuits := make([]*circuit, 0, len(b.circuits))
+	for key, c := range b.circuits {
+		keys = append(keys, key)
+		circuits = append(circuits, c)
+	}
+	b.mu.Unlock()
+
+	now := time.Now()
+	states := make(map[string]interfaces.CircuitState, len(keys))
+	for i, key := range keys {
+		states[key] = circuits[i].currentState(now)
+	}
+	return states
+}
+
+// circuit returns the circuit for a key, creating it on first use
+func (b *CircuitBreaker) circuit(key string) *circuit {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.circuits[key]
+	if !ok {
+		c = &circuit{key: key, breaker: b, state: interfaces.CircuitClosed}
+		b.circuits[key] = c
+	}
+	return c
+}
+
+// transition reports a state change to the metrics collector
+func (b *CircuitBreaker) transition(key string, from, to interfaces.CircuitState) {
+	if m, ok := b.metrics.(interfaces.CircuitBreakerMetrics); ok {
+		m.RecordCircuitStateChange(key, from, to)
+	}
+}
+
+// circuit is the breaker state of a single host and endpoint group
+type circuit struct {
+	key     string
+	breaker *CircuitBreaker
+
+	mu       sync.Mutex
+	state    interfaces.CircuitState
+	failures int
+	openedAt time.Time
+	probes   int
+	// generation changes on every state change, so that outcomes of requests
+	// admitted in an earlier state are not applied to the current one
+	generation uint64
+}
+
+// admission is the ticket of a request admitted by a circuit
+type admission struct {
+	generation uint64
+	probe      bool // Admitted while half-open to test the server
+}
+
+// setState changes the state and starts a new generation; callers must hold c.mu
+func (c *circuit) setState(state interfaces.CircuitState, now time.Time) {
+	c.state = state
+	c.generation++
+	switch state {
+	case interfaces.CircuitOpen:
+		c.openedAt = now
+	case interfaces.CircuitHalfOpen:
+		c.probes = 0
+	case interfaces.CircuitClosed:
+		c.failures = 0
+	}
+}
+
+// currentState returns the state as seen by a request arriving at now
+func (c *circuit) currentState(now time.Time) interfaces.CircuitState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state == interfaces.CircuitOpen && now.Sub(c.openedAt) >= c.breaker.config.OpenTimeout {
+		return interfaces.CircuitHalfOpen
+	}
+	return c.state
+}
+
+// allow admits a request or returns a CircuitOpenError
+func (c *circuit) allow(now time.Time) (admission, error) {
+	c.mu.Lock()
+	from := c.state
+	cfg := c.breaker.config
+
+	switch c.state {
+	case interfaces.CircuitOpen:
+		if wait := cfg.OpenTimeout - now.Sub(c.openedAt); wait > 0 {
+			c.mu.Unlock()
+			return admission{}, &errors.CircuitOpenError{Key: c.key, RetryAfter: wait}
+		}
+		c.setState(interfaces.CircuitHalfOpen, now)
+	case interfaces.CircuitHalfOpen:
+		if c.probes >= cfg.HalfOpenMaxRequests {
+			c.mu.Unlock()
+			return admission{}, &errors.CircuitOpenError{Key: c.key}
+		}
+	}
+
+	adm := admission{generation: c.generation, probe: c.state == interfaces.CircuitHalfOpen}
+	if adm.probe {
+		c.probes++
+	}
+	to := c.state
+	c.mu.Unlock()
+
+	if from != to {
+		c.breaker.transition(c.key, from, to)
+	}
+	return adm, nil
+}
+
+// record updates the circuit with the outcome of an admitted request. Only
+// requests admitted in the current state count: a slow request sent before
+// the circuit opened neither closes nor re-opens it, and only probes decide
+// the outcome of the half-open state.
+func (c *circuit) record(now time.Time, adm admission, failed bool) {
+	c.mu.Lock()
+	if adm.generation != c.generation {
+		c.mu.Unlock()
+		return
+	}
+	from := c.state
+
+	switch c.state {
+	case interfaces.CircuitClosed:
+		if !failed {
+			c.failures = 0
+			break
+		}
+		c.failures++
+		if c.failures >= c.breaker.config.FailureThreshold {
+			c.setState(interfaces.CircuitOpen, now)
+		}
+	case interfaces.CircuitHalfOpen:
+		c.probes--
+		if failed {
+			c.setState(interfaces.CircuitOpen, now)
+		} else {
+			c.setState(interfaces.CircuitClosed, now)
+		}
+	}
+
+	to := c.state
+	c.mu.Unlock()
+
+	if from != to {
+		c.breaker.transition(c.key, from, to)
+	}
+}
+
+// release gives back an admitted request without recording an outcome
+func (c *circuit) release(adm admission) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if adm.probe && adm.generation == c.generation {
+		c.probes--
+	}
+}
+
+// circuitBreakerTransport fails fast while the request's circuit is open
+type circuitBreakerTransport struct {
+	next    http.RoundTripper
+	breaker *CircuitBreaker
+}
+
+func (t *circuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := req.URL.Host + " " + string(interfaces.ClassifyEndpoint(req.Method, req.URL.Path))
+	c := t.breaker.circuit(key)
+
+	adm, err := c.allow(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+
+	// Requests abandoned by the caller, cut short by the caller's own
+	// deadline or held back by the client-side rate limiter say nothing about
+	// the server's health. The client's Timeout is not the caller's and
+	// counts as a failure.
+	if err != nil && (interfaces.CallerContext(req.Context()).Err() != nil || stderrors.Is(err, errors.ErrRateLimited)) {
+		c.release(adm)
+		return resp, err
+	}
+
+	c.record(time.Now(), adm, err != nil || resp.StatusCode >= 500)
+	return resp, err
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/eka-care/eka-sdk-go/internal/errors"
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+func newTestCircuit() *circuit {
+	b := NewCircuitBreaker(interfaces.CircuitBreakerConfig{
+		FailureThreshold:    1,
+		OpenTimeout:         time.Second,
+		HalfOpenMaxRequests: 1,
+	}, nil)
+	return b.circuit("host group")
+}
+
+func mustAllow(t *testing.T, c *circuit, now time.Time) admission {
+	t.Helper()
+	adm, err := c.allow(now)
+	if err != nil {
+		t.Fatalf("allow: %v", err)
+	}
+	return adm
+}
+
+func TestCircuitIgnoresRequestsAdmittedBeforeHalfOpen(t *testing.T) {
+	for _, slowFailed := range []bool{false, true} {
+		c := newTestCircuit()
+		now := time.Now()
+
+		slow := mustAllow(t, c, now)
+		c.record(now, mustAllow(t, c, now), true)
+		if state := c.currentState(now); state != interfaces.CircuitOpen {
+			t.Fatalf("state after failure = %s, want open", state)
+		}
+
+		now = now.Add(time.Second)
+		probe := mustAllow(t, c, now)
+		if !probe.probe {
+			t.Fatal("request after OpenTimeout was not admitted as a probe")
+		}
+
+		// The slow request from before the trip neither closes nor re-opens
+		// the circuit, nor frees the probe slot
+		c.record(now, slow, slowFailed)
+		if state := c.currentState(now); state != interfaces.CircuitHalfOpen {
+			t.Fatalf("state after stale outcome (failed=%v) = %s, want half-open", slowFailed, state)
+		}
+		if _, err := c.allow(now); !stderrors.Is(err, errors.ErrCircuitOpen) {
+			t.Fatalf("second probe admitted, err = %v", err)
+		}
+
+		c.record(now, probe, false)
+		if state := c.currentState(now); state != interfaces.CircuitClosed {
+			t.Fatalf("state after successful probe = %s, want closed", state)
+		}
+	}
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestCircuitBreakerIgnoresCallerDeadlines(t *testing.T) {
+	b := NewCircuitBreaker(interfaces.CircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute}, nil)
+	rt := b.Middleware()(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	}))
+
+	// The caller's own short deadline does not count against the server
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(interfaces.ContextWithCaller(ctx), http.MethodGet, "https://api.eka.care/abdm/v1/profile", nil)
+	if _, err := rt.RoundTrip(req); !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want deadline exceeded", err)
+	}
+	for key, state := range b.States() {
+		if state != interfaces.CircuitClosed {
+			t.Fatalf("%s is %s after a caller deadline, want closed", key, state)
+		}
+	}
+
+	// A timeout below the caller's context, like the HTTP client's own
+	// Timeout, is a server failure
+	caller := interfaces.ContextWithCaller(context.Background())
+	clientCtx, cancelClient := context.WithTimeout(caller, 10*time.Millisecond)
+	defer cancelClient()
+	req, _ = http.NewRequestWithContext(clientCtx, http.MethodGet, "https://api.eka.care/abdm/v1/profile", nil)
+	if _, err := rt.RoundTrip(req); !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want deadline exceeded", err)
+	}
+	for key, state := range b.States() {
+		if state != interfaces.CircuitOpen {
+			t.Fatalf("%s is %s after a client timeout, want open", key, state)
+		}
+	}
+}
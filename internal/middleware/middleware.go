@@ -1,23 +1,32 @@
 package middleware
 
 import (
+	"io"
 	"net/http"
 	"time"
 
 	"github.com/eka-care/eka-sdk-go/internal/interfaces"
 )
 
-// RetryMiddleware creates a retry middleware
-func RetryMiddleware(maxRetries int, backoff time.Duration) interfaces.Middleware {
+// RetryMiddleware creates a retry middleware. Only idempotent requests, or
+// writes carrying an Idempotency-Key header, are retried, so that an OTP is
+// never sent twice by accident.
+// onRetry, if not nil, is called before every retry with the number of the
+// attempt about to be sent and the outcome of the previous one.
+func RetryMiddleware(maxRetries int, backoff time.Duration, onRetry RetryFunc) interfaces.Middleware {
 	return func(next http.RoundTripper) http.RoundTripper {
 		return &retryTransport{
 			next:       next,
 			maxRetries: maxRetries,
 			backoff:    backoff,
+			onRetry:    onRetry,
 		}
 	}
 }
 
+// RetryFunc observes retries; resp and err describe the failed attempt
+type RetryFunc func(req *http.Request, attempt int, resp *http.Response, err error, delay time.Duration)
+
 // LoggingMiddleware creates a logging middleware
 func LoggingMiddleware(logger interfaces.Logger) interfaces.Middleware {
 	return func(next http.RoundTripper) http.RoundTripper {
@@ -53,27 +62,65 @@ type retryTransport struct {
 	next       http.RoundTripper
 	maxRetries int
 	backoff    time.Duration
+	onRetry    RetryFunc
 }
 
 func (r *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	var lastErr error
+	retryable := isIdempotent(req)
+	attemptReq := req
+
+	for attempt := 0; ; attempt++ {
+		resp, err := r.next.RoundTrip(attemptReq)
+		if !retryable || attempt >= r.maxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
 
-	for attempt := 0; attempt <= r.maxRetries; attempt++ {
-		resp, err := r.next.RoundTrip(req)
-		if err == nil && resp.StatusCode < 500 {
-			return resp, nil
+		delay := r.backoff * time.Duration(attempt+1)
+		if r.onRetry != nil {
+			r.onRetry(req, attempt+2, resp, err, delay)
 		}
 
-		if err != nil {
-			lastErr = err
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
 		}
 
-		if attempt < r.maxRetries {
-			time.Sleep(r.backoff * time.Duration(attempt+1))
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
 		}
+
+		// Each attempt needs a fresh copy of the request body
+		attemptReq = req.Clone(req.Context())
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+	}
+}
+
+// shouldRetry returns true for network errors and 5xx responses
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
 	}
+	return err != nil || resp.StatusCode >= 500
+}
 
-	return nil, lastErr
+// isIdempotent returns true if the request can be sent again without side
+// effects; writes opt in by carrying an Idempotency-Key header
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != ""
 }
 
 // loggingTransport implements logging
@@ -122,5 +169,10 @@ func (m *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	duration := time.Since(start)
 	m.metrics.RecordRequest(req, resp, err, duration)
 
+	if otp, ok := m.metrics.(interfaces.OTPMetrics); ok && err == nil && resp.StatusCode < 400 &&
+		interfaces.ClassifyEndpoint(req.Method, req.URL.Path) == interfaces.EndpointGroupOTP {
+		otp.RecordOTPSend(interfaces.OperationFromContext(req.Context()))
+	}
+
 	return resp, err
 }
@@ -0,0 +1,214 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/eka-care/eka-sdk-go/internal/errors"
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+const (
+	// minRateFactor bounds how far 429 responses can slow a bucket down
+	minRateFactor = 0.125
+	// recoveryFactor is the share of the configured rate regained per successful response
+	recoveryFactor = 0.1
+)
+
+// RateLimitMiddleware creates a client-side rate limiting middleware with one
+// token bucket per endpoint group. Groups without a configured limit are not
+// throttled, but every group backs off when the server answers 429.
+func RateLimitMiddleware(limits map[interfaces.EndpointGroup]interfaces.RateLimit) interfaces.Middleware {
+	return NewRateLimiter(limits).Middleware()
+}
+
+// Middleware returns a middleware that throttles requests with the limiter's
+// buckets; every middleware returned shares them
+func (l *RateLimiter) Middleware() interfaces.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &rateLimitTransport{
+			next:    next,
+			limiter: l,
+		}
+	}
+}
+
+// RateLimiter holds the token buckets of all endpoint groups
+type RateLimiter struct {
+	limits  map[interfaces.EndpointGroup]interfaces.RateLimit
+	mu      sync.Mutex
+	buckets map[interfaces.EndpointGroup]*bucket
+}
+
+// NewRateLimiter creates a rate limiter with the given per-group limits
+func NewRateLimiter(limits map[interfaces.EndpointGroup]interfaces.RateLimit) *RateLimiter {
+	return &RateLimiter{
+		limits:  limits,
+		buckets: make(map[interfaces.EndpointGroup]*bucket),
+	}
+}
+
+// bucket returns the token bucket of a group, creating it on first use
+func (l *RateLimiter) bucket(group interfaces.EndpointGroup) *bucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[group]
+	if !ok {
+		b = newBucket(l.limits[group])
+		l.buckets[group] = b
+	}
+	return b
+}
+
+// bucket is a token bucket whose rate drops on 429 responses and recovers on success
+type bucket struct {
+	mu          sync.Mutex
+	limit       interfaces.RateLimit
+	rate        float64 // current rate, at most limit.Rate
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func newBucket(limit interfaces.RateLimit) *bucket {
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+	return &bucket{
+		limit:  limit,
+		rate:   limit.Rate,
+		tokens: float64(limit.Burst),
+		last:   time.Now(),
+	}
+}
+
+// unlimited returns true if the bucket only honours server pauses
+func (b *bucket) unlimited() bool {
+	return b.limit.Rate <= 0
+}
+
+// reserve takes a token and returns how long the caller must wait before using it
+func (b *bucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var wait time.Duration
+	if !b.unlimited() {
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+		b.tokens--
+		if b.tokens < 0 {
+			wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+		}
+	}
+	if pause := b.pausedUntil.Sub(now); pause > wait {
+		wait = pause
+	}
+	return wait
+}
+
+// cancel returns a token taken by reserve that will not be used
+func (b *bucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.unlimited() {
+		b.tokens++
+	}
+}
+
+// throttle pauses the bucket until the given time and halves its rate
+func (b *bucket) throttle(until time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+	if !b.unlimited() {
+		b.rate = math.Max(b.rate/2, b.limit.Rate*minRateFactor)
+		b.tokens = math.Min(b.tokens, 0)
+	}
+}
+
+// recover moves the rate back towards the configured rate after a successful response
+func (b *bucket) recover() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.unlimited() && b.rate < b.limit.Rate {
+		b.rate = math.Min(b.limit.Rate, b.rate+b.limit.Rate*recoveryFactor)
+	}
+}
+
+// rateLimitTransport waits for a token of the request's endpoint group before sending it
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *RateLimiter
+}
+
+func (r *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	b := r.limiter.bucket(interfaces.ClassifyEndpoint(req.Method, req.URL.Path))
+	ctx := req.Context()
+
+	now := time.Now()
+	if wait := b.reserve(now); wait > 0 {
+		if deadline, ok := ctx.Deadline(); ok && deadline.Before(now.Add(wait)) {
+			b.cancel()
+			return nil, fmt.Errorf("%w: need to wait %s", errors.ErrRateLimited, wait)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			b.cancel()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		b.throttle(time.Now().Add(retryAfter(resp.Header, time.Now())))
+	} else if resp.StatusCode < 400 {
+		b.recover()
+	}
+	return resp, nil
+}
+
+// defaultRetryAfter is used when a 429 response carries no rate-limit headers
+const defaultRetryAfter = time.Second
+
+// retryAfter reads how long to back off from Retry-After or X-RateLimit-Reset
+func retryAfter(header http.Header, now time.Time) time.Duration {
+	if v := header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return at.Sub(now)
+		}
+	}
+
+	if v := header.Get("X-RateLimit-Reset"); v != "" {
+		if reset, err := strconv.ParseInt(v, 10, 64); err == nil {
+			// Large values are Unix timestamps, small ones are seconds from now
+			if reset > now.Unix()/2 {
+				return time.Unix(reset, 0).Sub(now)
+			}
+			return time.Duration(reset) * time.Second
+		}
+	}
+
+	return defaultRetryAfter
+}
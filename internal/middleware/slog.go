@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+	"github.com/eka-care/eka-sdk-go/internal/redact"
+)
+
+// maxLoggedBody bounds the size of bodies written to debug logs
+const maxLoggedBody = 4096
+
+// SlogMiddleware creates a middleware that logs every attempt with log/slog.
+// Only the method and path are logged, never the query string, and when
+// logBodies is set request and response bodies are logged at debug level
+// with Aadhaar numbers, mobile numbers, OTPs and tokens masked.
+func SlogMiddleware(logger *slog.Logger, logBodies bool) interfaces.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &slogTransport{
+			next:      next,
+			logger:    logger,
+			logBodies: logBodies,
+		}
+	}
+}
+
+// slogTransport implements structured logging
+type slogTransport struct {
+	next      http.RoundTripper
+	logger    *slog.Logger
+	logBodies bool
+}
+
+func (s *slogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	debug := s.logBodies && s.logger.Enabled(ctx, slog.LevelDebug)
+	// The attempt counter is incremented below this middleware
+	attempt := interfaces.AttemptFromContext(ctx) + 1
+
+	if debug {
+		attrs := []slog.Attr{
+			slog.String("method", req.Method),
+			slog.String("path", req.URL.Path),
+			slog.Int("attempt", attempt),
+			slog.Any("headers", redact.Header(req.Header)),
+		}
+		if body := requestBody(req); len(body) > 0 {
+			attrs = append(attrs, slog.String("body", loggableBody(body, req.Header.Get("Content-Type"))))
+		}
+		s.logger.LogAttrs(ctx, slog.LevelDebug, "eka request", attrs...)
+	}
+
+	start := time.Now()
+	resp, err := s.next.RoundTrip(req)
+	latency := time.Since(start)
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Duration("latency", latency),
+		slog.Int("attempt", attempt),
+	}
+	if id := req.Header.Get("X-Request-Id"); id != "" {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", redact.String(err.Error())))
+		s.logger.LogAttrs(ctx, slog.LevelError, "eka request failed", attrs...)
+		return resp, err
+	}
+
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	if id := resp.Header.Get("X-Request-Id"); id != "" {
+		attrs = append(attrs, slog.String("response_request_id", id))
+	}
+	if debug {
+		if body := responseBody(resp); len(body) > 0 {
+			attrs = append(attrs, slog.String("body", loggableBody(body, resp.Header.Get("Content-Type"))))
+		}
+	}
+	s.logger.LogAttrs(ctx, levelForStatus(resp.StatusCode), "eka response", attrs...)
+
+	return resp, nil
+}
+
+// levelForStatus returns the log level for a response status
+func levelForStatus(status int) slog.Level {
+	switch {
+	case status >= 500:
+		return slog.LevelError
+	case status >= 400:
+		return slog.LevelWarn
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// requestBody returns a copy of the request body without consuming it
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	data, _ := io.ReadAll(io.LimitReader(body, maxLoggedBody+1))
+	return data
+}
+
+// responseBody reads the response body and replaces it so that callers can still read it
+func responseBody(resp *http.Response) []byte {
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// loggableBody masks a body for logging; non-JSON bodies such as the ABHA
+// card image are summarised by size
+func loggableBody(body []byte, contentType string) string {
+	if contentType != "" && !strings.Contains(contentType, "json") && !strings.HasPrefix(contentType, "text/") {
+		return "<" + contentType + " body omitted>"
+	}
+	masked := redact.JSON(body)
+	if len(masked) > maxLoggedBody {
+		return string(masked[:maxLoggedBody]) + "...(truncated)"
+	}
+	return string(masked)
+}
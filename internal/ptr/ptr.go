@@ -0,0 +1,11 @@
+// Package ptr helps with the optional pointer fields of API responses
+package ptr
+
+// Value returns *p, or the zero value if p is nil
+func Value[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
@@ -0,0 +1,176 @@
+// Package redact masks personal and secret data (Aadhaar and mobile numbers,
+// OTPs, tokens and credentials) before it reaches logs, traces or files.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Masked replaces values that are hidden entirely
+const Masked = "[REDACTED]"
+
+// Rule describes how the value of a sensitive field is masked
+type Rule int
+
+const (
+	// RuleFull replaces the whole value
+	RuleFull Rule = iota
+	// RuleKeepLast4 keeps the last four characters, e.g. "********1234"
+	RuleKeepLast4
+)
+
+// DefaultFields are the JSON fields masked by the default redactor
+var DefaultFields = map[string]Rule{
+	"aadhaar":        RuleKeepLast4,
+	"aadhaar_number": RuleKeepLast4,
+	"mobile":         RuleKeepLast4,
+	"mobile_number":  RuleKeepLast4,
+	"identifier":     RuleKeepLast4,
+	"otp":            RuleFull,
+	"token":          RuleFull,
+	"access_token":   RuleFull,
+	"refresh_token":  RuleFull,
+	"min_token":      RuleFull,
+	"user_x_token":   RuleFull,
+	"client_secret":  RuleFull,
+	"password":       RuleFull,
+}
+
+// sensitiveHeaders are masked entirely by Header
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-Api-Key", "Proxy-Authorization"}
+
+var (
+	// aadhaarPattern matches 12 digit Aadhaar numbers, optionally grouped by 4
+	aadhaarPattern = regexp.MustCompile(`\b\d{4}[ -]?\d{4}[ -]?\d{4}\b`)
+	// mobilePattern matches 10 digit Indian mobile numbers with an optional +91 prefix
+	mobilePattern = regexp.MustCompile(`(\+91[ -]?)?\b[6-9]\d{9}\b`)
+)
+
+// Redactor masks sensitive JSON fields and free text
+type Redactor struct {
+	fields map[string]Rule
+}
+
+// New creates a redactor masking DefaultFields plus the given extra fields.
+// Field names are matched case-insensitively.
+func New(extra map[string]Rule) *Redactor {
+	fields := make(map[string]Rule, len(DefaultFields)+len(extra))
+	for name, rule := range DefaultFields {
+		fields[name] = rule
+	}
+	for name, rule := range extra {
+		fields[strings.ToLower(name)] = rule
+	}
+	return &Redactor{fields: fields}
+}
+
+// defaultRedactor is used by the package level functions
+var defaultRedactor = New(nil)
+
+// JSON masks a JSON body with the default redactor
+func JSON(body []byte) []byte {
+	return defaultRedactor.JSON(body)
+}
+
+// String masks Aadhaar and mobile numbers in free text with the default redactor
+func String(s string) string {
+	return defaultRedactor.String(s)
+}
+
+// JSON returns a copy of body with sensitive fields masked and Aadhaar and
+// mobile numbers masked in every other string value. Bodies that are not
+// valid JSON are masked as free text.
+func (r *Redactor) JSON(body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return []byte(r.String(string(body)))
+	}
+
+	masked, err := json.Marshal(r.value("", v))
+	if err != nil {
+		return []byte(Masked)
+	}
+	return masked
+}
+
+// value masks a decoded JSON value found under the given field name
+func (r *Redactor) value(field string, v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, child := range val {
+			val[k] = r.value(k, child)
+		}
+		return val
+	case []interface{}:
+		for i, child := range val {
+			val[i] = r.value(field, child)
+		}
+		return val
+	case string:
+		if rule, ok := r.fields[strings.ToLower(field)]; ok {
+			return Mask(val, rule)
+		}
+		return r.String(val)
+	case json.Number:
+		if rule, ok := r.fields[strings.ToLower(field)]; ok {
+			return Mask(val.String(), rule)
+		}
+		return val
+	default:
+		return val
+	}
+}
+
+// String masks Aadhaar and mobile numbers in free text, keeping the last four digits
+func (r *Redactor) String(s string) string {
+	s = aadhaarPattern.ReplaceAllStringFunc(s, func(m string) string { return Mask(m, RuleKeepLast4) })
+	return mobilePattern.ReplaceAllStringFunc(s, func(m string) string { return Mask(m, RuleKeepLast4) })
+}
+
+// Field masks the value of a named field, if it is sensitive, or masks
+// Aadhaar and mobile numbers in it otherwise
+func (r *Redactor) Field(name, value string) string {
+	if rule, ok := r.fields[strings.ToLower(name)]; ok {
+		return Mask(value, rule)
+	}
+	return r.String(value)
+}
+
+// Mask masks a value according to rule
+func Mask(value string, rule Rule) string {
+	if value == "" {
+		return ""
+	}
+	if rule == RuleKeepLast4 && len(value) > 4 {
+		return strings.Repeat("*", len(value)-4) + value[len(value)-4:]
+	}
+	return Masked
+}
+
+// Header returns a copy of h with credentials masked and Aadhaar and mobile
+// numbers masked in every other value
+func Header(h http.Header) http.Header {
+	masked := make(http.Header, len(h))
+	for key, values := range h {
+		masked[key] = make([]string, len(values))
+		for i, v := range values {
+			masked[key][i] = String(v)
+		}
+	}
+	for _, key := range sensitiveHeaders {
+		if masked.Get(key) != "" {
+			masked.Set(key, Masked)
+		}
+	}
+	return masked
+}
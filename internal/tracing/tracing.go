@@ -0,0 +1,186 @@
+// Package tracing creates OpenTelemetry spans for SDK operations and their
+// HTTP attempts. Span attributes never carry personal data: query strings,
+// bodies and patient headers are not recorded and error messages are masked.
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+	"github.com/eka-care/eka-sdk-go/internal/redact"
+)
+
+// instrumentationName identifies the SDK as the instrumentation library
+const instrumentationName = "github.com/eka-care/eka-sdk-go"
+
+// Attribute keys set by the SDK beyond the HTTP semantic conventions
+const (
+	AttrOperation       = attribute.Key("eka.operation")
+	AttrSkipState       = attribute.Key("abdm.skip_state")
+	AttrErrorCode       = attribute.Key("abdm.error.code")
+	AttrErrorSourceCode = attribute.Key("abdm.error.source_code")
+)
+
+// idPattern matches identifiers embedded in paths, such as pincodes
+var idPattern = regexp.MustCompile(`\d{6,}`)
+
+// Tracer creates operation and attempt spans
+type Tracer struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// New creates a tracer from the given provider, propagating W3C trace context
+func New(provider trace.TracerProvider) *Tracer {
+	return &Tracer{
+		tracer:     provider.Tracer(instrumentationName),
+		propagator: propagation.TraceContext{},
+	}
+}
+
+// Start starts the span of a service operation such as abdm.registration.AadhaarVerify
+func (t *Tracer) Start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(AttrOperation.String(operation)),
+	)
+}
+
+// End records the outcome of a service operation and ends its span
+func (t *Tracer) End(span trace.Span, resp *interfaces.HTTPResponse, err error) {
+	defer span.End()
+
+	if resp != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if state := skipState(resp.Body); state != "" {
+			span.SetAttributes(AttrSkipState.String(state))
+		}
+	}
+
+	if err != nil {
+		var coded interface {
+			StatusCode() int
+			SourceErrorCode() string
+		}
+		if errors.As(err, &coded) {
+			span.SetAttributes(AttrErrorCode.Int(coded.StatusCode()))
+			if source := coded.SourceErrorCode(); source != "" {
+				span.SetAttributes(AttrErrorSourceCode.String(source))
+			}
+		}
+		span.SetStatus(codes.Error, redact.String(err.Error()))
+	}
+}
+
+// skipState extracts the ABDM skip_state from a JSON response body
+func skipState(body []byte) string {
+	var v struct {
+		SkipState string `json:"skip_state"`
+	}
+	if len(body) == 0 || body[0] != '{' || json.Unmarshal(body, &v) != nil {
+		return ""
+	}
+	return v.SkipState
+}
+
+// Middleware returns a middleware creating a client span per HTTP attempt
+// and injecting the W3C traceparent header into the outgoing request
+func (t *Tracer) Middleware() interfaces.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &tracingTransport{
+			next:   next,
+			tracer: t,
+		}
+	}
+}
+
+// tracingTransport implements per-attempt spans
+type tracingTransport struct {
+	next   http.RoundTripper
+	tracer *Tracer
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The attempt counter is incremented below this middleware
+	attempt := interfaces.AttemptFromContext(req.Context()) + 1
+
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method),
+		attribute.String("url.path", idPattern.ReplaceAllString(req.URL.Path, "{id}")),
+		attribute.String("server.address", req.URL.Hostname()),
+	}
+	if port := serverPort(req); port > 0 {
+		attrs = append(attrs, attribute.Int("server.port", port))
+	}
+	if attempt > 1 {
+		attrs = append(attrs, attribute.Int("http.request.resend_count", attempt-1))
+	}
+	if operation := interfaces.OperationFromContext(req.Context()); operation != "" {
+		attrs = append(attrs, AttrOperation.String(operation))
+	}
+
+	ctx, span := t.tracer.tracer.Start(req.Context(), req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	defer span.End()
+
+	// Inject into a copy; a RoundTripper must not modify its request
+	outReq := req.Clone(ctx)
+	t.tracer.propagator.Inject(ctx, propagation.HeaderCarrier(outReq.Header))
+
+	resp, err := t.next.RoundTrip(outReq)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.type", errorType(err)))
+		span.SetStatus(codes.Error, redact.String(err.Error()))
+		return resp, err
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= 400 {
+		span.SetAttributes(attribute.String("error.type", strconv.Itoa(resp.StatusCode)))
+		span.SetStatus(codes.Error, "")
+	}
+	return resp, nil
+}
+
+// serverPort returns the port the request is sent to
+func serverPort(req *http.Request) int {
+	if port := req.URL.Port(); port != "" {
+		p, _ := strconv.Atoi(port)
+		return p
+	}
+	switch req.URL.Scheme {
+	case "https":
+		return 443
+	case "http":
+		return 80
+	}
+	return 0
+}
+
+// errorType classifies a transport error for the error.type attribute
+func errorType(err error) string {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "timeout"
+	default:
+		return "_OTHER"
+	}
+}
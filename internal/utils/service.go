@@ -17,10 +17,8 @@ type Service struct {
 	http   *http.Client
 }
 
-// NewService creates a new utilities service
-func NewService(config interfaces.Config) *Service {
-	httpClient := http.NewClientFromInterface(config)
-
+// NewService creates a new utilities service sharing the given HTTP client
+func NewService(config interfaces.Config, httpClient *http.Client) *Service {
 	return &Service{
 		config: config,
 		http:   httpClient,
@@ -0,0 +1,20 @@
+package ekasdk
+
+import "github.com/eka-care/eka-sdk-go/internal/interfaces"
+
+// MetricsCollector records one entry per service call
+type MetricsCollector = interfaces.MetricsCollector
+
+// Optional interfaces a MetricsCollector may implement to receive more events
+type (
+	// RetryMetrics counts retries per operation
+	RetryMetrics = interfaces.RetryMetrics
+	// TokenRefreshMetrics counts access token refreshes
+	TokenRefreshMetrics = interfaces.TokenRefreshMetrics
+	// OTPMetrics counts OTPs sent by ABDM per operation
+	OTPMetrics = interfaces.OTPMetrics
+	// ErrorCodeMetrics counts API errors per operation and code
+	ErrorCodeMetrics = interfaces.ErrorCodeMetrics
+	// CircuitBreakerMetrics counts circuit breaker state changes
+	CircuitBreakerMetrics = interfaces.CircuitBreakerMetrics
+)
@@ -0,0 +1,208 @@
+// Package metrics provides a Prometheus collector for the SDK.
+//
+// Metrics are labelled by operation name, e.g. abdm.registration.AadhaarVerify,
+// and status class rather than by raw path, so that ABHA numbers and other
+// identifiers never become label values:
+//
+//	collector, err := metrics.NewPrometheusCollector(prometheus.DefaultRegisterer)
+//	if err != nil {
+//		return err
+//	}
+//	client := ekasdk.New(ekasdk.WithMetricsCollector(collector))
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// DefaultNamespace prefixes every metric name unless overridden with WithNamespace
+const DefaultNamespace = "eka_sdk"
+
+// unknownOperation labels requests made outside a service method
+const unknownOperation = "unknown"
+
+// PrometheusCollector records SDK metrics as Prometheus counters and histograms
+type PrometheusCollector struct {
+	requests      *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	retries       *prometheus.CounterVec
+	tokenRefresh  *prometheus.CounterVec
+	otpSends      *prometheus.CounterVec
+	errors        *prometheus.CounterVec
+	circuitStates *prometheus.CounterVec
+}
+
+// Option configures a PrometheusCollector
+type Option func(*options)
+
+type options struct {
+	namespace   string
+	buckets     []float64
+	constLabels prometheus.Labels
+}
+
+// WithNamespace sets the metric name prefix
+func WithNamespace(namespace string) Option {
+	return func(o *options) {
+		o.namespace = namespace
+	}
+}
+
+// WithBuckets sets the latency histogram buckets, in seconds
+func WithBuckets(buckets []float64) Option {
+	return func(o *options) {
+		o.buckets = buckets
+	}
+}
+
+// WithConstLabels adds labels with fixed values to every metric
+func WithConstLabels(labels prometheus.Labels) Option {
+	return func(o *options) {
+		o.constLabels = labels
+	}
+}
+
+// NewPrometheusCollector creates a collector and registers its metrics with reg
+func NewPrometheusCollector(reg prometheus.Registerer, opts ...Option) (*PrometheusCollector, error) {
+	o := &options{
+		namespace: DefaultNamespace,
+		buckets:   prometheus.DefBuckets,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	c := &PrometheusCollector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   o.namespace,
+			Name:        "requests_total",
+			Help:        "Service calls by operation and status class, counted once after retries.",
+			ConstLabels: o.constLabels,
+		}, []string{"operation", "status_class"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   o.namespace,
+			Name:        "request_duration_seconds",
+			Help:        "Service call latency including retries.",
+			Buckets:     o.buckets,
+			ConstLabels: o.constLabels,
+		}, []string{"operation", "status_class"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   o.namespace,
+			Name:        "retries_total",
+			Help:        "Retried HTTP attempts by operation.",
+			ConstLabels: o.constLabels,
+		}, []string{"operation"}),
+		tokenRefresh: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   o.namespace,
+			Name:        "token_refreshes_total",
+			Help:        "Access token refreshes by result.",
+			ConstLabels: o.constLabels,
+		}, []string{"result"}),
+		otpSends: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   o.namespace,
+			Name:        "otp_sends_total",
+			Help:        "OTPs sent or resent by ABDM, by operation.",
+			ConstLabels: o.constLabels,
+		}, []string{"operation"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   o.namespace,
+			Name:        "errors_total",
+			Help:        "API errors by operation and error code.",
+			ConstLabels: o.constLabels,
+		}, []string{"operation", "code"}),
+		circuitStates: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   o.namespace,
+			Name:        "circuit_state_changes_total",
+			Help:        "Circuit breaker state changes by circuit and target state.",
+			ConstLabels: o.constLabels,
+		}, []string{"circuit", "from", "to"}),
+	}
+
+	for _, collector := range c.collectors() {
+		if err := reg.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// collectors returns every metric of the collector
+func (c *PrometheusCollector) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		c.requests,
+		c.duration,
+		c.retries,
+		c.tokenRefresh,
+		c.otpSends,
+		c.errors,
+		c.circuitStates,
+	}
+}
+
+// RecordRequest implements interfaces.MetricsCollector
+func (c *PrometheusCollector) RecordRequest(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	operation := operationLabel(interfaces.OperationFromContext(req.Context()))
+	class := statusClass(resp, err)
+
+	c.requests.WithLabelValues(operation, class).Inc()
+	c.duration.WithLabelValues(operation, class).Observe(duration.Seconds())
+}
+
+// RecordRetry implements interfaces.RetryMetrics
+func (c *PrometheusCollector) RecordRetry(operation string, attempt int) {
+	c.retries.WithLabelValues(operationLabel(operation)).Inc()
+}
+
+// RecordTokenRefresh implements interfaces.TokenRefreshMetrics
+func (c *PrometheusCollector) RecordTokenRefresh(success bool) {
+	result := "success"
+	if !success {
+		result = "failure"
+	}
+	c.tokenRefresh.WithLabelValues(result).Inc()
+}
+
+// RecordOTPSend implements interfaces.OTPMetrics
+func (c *PrometheusCollector) RecordOTPSend(operation string) {
+	c.otpSends.WithLabelValues(operationLabel(operation)).Inc()
+}
+
+// RecordErrorCode implements interfaces.ErrorCodeMetrics
+func (c *PrometheusCollector) RecordErrorCode(operation string, code string) {
+	c.errors.WithLabelValues(operationLabel(operation), code).Inc()
+}
+
+// RecordCircuitStateChange implements interfaces.CircuitBreakerMetrics
+func (c *PrometheusCollector) RecordCircuitStateChange(key string, from, to interfaces.CircuitState) {
+	c.circuitStates.WithLabelValues(key, from.String(), to.String()).Inc()
+}
+
+// operationLabel returns the operation label value
+func operationLabel(operation string) string {
+	if operation == "" {
+		return unknownOperation
+	}
+	return operation
+}
+
+// statusClass returns 2xx, 3xx, 4xx or 5xx, or "error" if no response was received
+func statusClass(resp *http.Response, err error) string {
+	if err != nil || resp == nil {
+		return "error"
+	}
+	switch {
+	case resp.StatusCode >= 500:
+		return "5xx"
+	case resp.StatusCode >= 400:
+		return "4xx"
+	case resp.StatusCode >= 300:
+		return "3xx"
+	default:
+		return "2xx"
+	}
+}
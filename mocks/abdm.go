@@ -0,0 +1,1722 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+	"github.com/eka-care/eka-sdk-go/services/abdm"
+	"github.com/eka-care/eka-sdk-go/services/abdm/abha/login"
+	"github.com/eka-care/eka-sdk-go/services/abdm/abha/profile"
+	"github.com/eka-care/eka-sdk-go/services/abdm/abha/registration"
+	"sync"
+)
+
+// Ensure, that LoginAPIMock does implement abdm.LoginAPI.
+// If this is not the case, regenerate this file with moq.
+var _ abdm.LoginAPI = &LoginAPIMock{}
+
+// LoginAPIMock is a mock implementation of abdm.LoginAPI.
+//
+//	func TestSomethingThatUsesLoginAPI(t *testing.T) {
+//
+//		// make and configure a mocked abdm.LoginAPI
+//		mockedLoginAPI := &LoginAPIMock{
+//			LoginInitFunc: func(ctx context.Context, headers interfaces.Headers, req *login.InitLoginRequest, opts ...interfaces.RequestOption) (*login.InitLoginResponse, error) {
+//				panic("mock out the LoginInit method")
+//			},
+//			LoginVerifyFunc: func(ctx context.Context, headers interfaces.Headers, req *login.VerifyLoginOTPRequest, opts ...interfaces.RequestOption) (*login.VerifyLoginOTPResponse, error) {
+//				panic("mock out the LoginVerify method")
+//			},
+//			LoginWithPHRAddressFunc: func(ctx context.Context, headers interfaces.Headers, req *login.PhrAddressLoginRequest, opts ...interfaces.RequestOption) (*login.PhrAddressLoginResponse, error) {
+//				panic("mock out the LoginWithPHRAddress method")
+//			},
+//		}
+//
+//		// use mockedLoginAPI in code that requires abdm.LoginAPI
+//		// and then make assertions.
+//
+//	}
+type LoginAPIMock struct {
+	// LoginInitFunc mocks the LoginInit method.
+	LoginInitFunc func(ctx context.Context, headers interfaces.Headers, req *login.InitLoginRequest, opts ...interfaces.RequestOption) (*login.InitLoginResponse, error)
+
+	// LoginVerifyFunc mocks the LoginVerify method.
+	LoginVerifyFunc func(ctx context.Context, headers interfaces.Headers, req *login.VerifyLoginOTPRequest, opts ...interfaces.RequestOption) (*login.VerifyLoginOTPResponse, error)
+
+	// LoginWithPHRAddressFunc mocks the LoginWithPHRAddress method.
+	LoginWithPHRAddressFunc func(ctx context.Context, headers interfaces.Headers, req *login.PhrAddressLoginRequest, opts ...interfaces.RequestOption) (*login.PhrAddressLoginResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// LoginInit holds details about calls to the LoginInit method.
+		LoginInit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req *login.InitLoginRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// LoginVerify holds details about calls to the LoginVerify method.
+		LoginVerify []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req *login.VerifyLoginOTPRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// LoginWithPHRAddress holds details about calls to the LoginWithPHRAddress method.
+		LoginWithPHRAddress []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req *login.PhrAddressLoginRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+	}
+	lockLoginInit           sync.RWMutex
+	lockLoginVerify         sync.RWMutex
+	lockLoginWithPHRAddress sync.RWMutex
+}
+
+// LoginInit calls LoginInitFunc.
+func (mock *LoginAPIMock) LoginInit(ctx context.Context, headers interfaces.Headers, req *login.InitLoginRequest, opts ...interfaces.RequestOption) (*login.InitLoginResponse, error) {
+	if mock.LoginInitFunc == nil {
+		panic("LoginAPIMock.LoginInitFunc: method is nil but LoginAPI.LoginInit was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *login.InitLoginRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockLoginInit.Lock()
+	mock.calls.LoginInit = append(mock.calls.LoginInit, callInfo)
+	mock.lockLoginInit.Unlock()
+	return mock.LoginInitFunc(ctx, headers, req, opts...)
+}
+
+// LoginInitCalls gets all the calls that were made to LoginInit.
+// Check the length with:
+//
+//	len(mockedLoginAPI.LoginInitCalls())
+func (mock *LoginAPIMock) LoginInitCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     *login.InitLoginRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *login.InitLoginRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockLoginInit.RLock()
+	calls = mock.calls.LoginInit
+	mock.lockLoginInit.RUnlock()
+	return calls
+}
+
+// LoginVerify calls LoginVerifyFunc.
+func (mock *LoginAPIMock) LoginVerify(ctx context.Context, headers interfaces.Headers, req *login.VerifyLoginOTPRequest, opts ...interfaces.RequestOption) (*login.VerifyLoginOTPResponse, error) {
+	if mock.LoginVerifyFunc == nil {
+		panic("LoginAPIMock.LoginVerifyFunc: method is nil but LoginAPI.LoginVerify was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *login.VerifyLoginOTPRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockLoginVerify.Lock()
+	mock.calls.LoginVerify = append(mock.calls.LoginVerify, callInfo)
+	mock.lockLoginVerify.Unlock()
+	return mock.LoginVerifyFunc(ctx, headers, req, opts...)
+}
+
+// LoginVerifyCalls gets all the calls that were made to LoginVerify.
+// Check the length with:
+//
+//	len(mockedLoginAPI.LoginVerifyCalls())
+func (mock *LoginAPIMock) LoginVerifyCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     *login.VerifyLoginOTPRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *login.VerifyLoginOTPRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockLoginVerify.RLock()
+	calls = mock.calls.LoginVerify
+	mock.lockLoginVerify.RUnlock()
+	return calls
+}
+
+// LoginWithPHRAddress calls LoginWithPHRAddressFunc.
+func (mock *LoginAPIMock) LoginWithPHRAddress(ctx context.Context, headers interfaces.Headers, req *login.PhrAddressLoginRequest, opts ...interfaces.RequestOption) (*login.PhrAddressLoginResponse, error) {
+	if mock.LoginWithPHRAddressFunc == nil {
+		panic("LoginAPIMock.LoginWithPHRAddressFunc: method is nil but LoginAPI.LoginWithPHRAddress was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *login.PhrAddressLoginRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockLoginWithPHRAddress.Lock()
+	mock.calls.LoginWithPHRAddress = append(mock.calls.LoginWithPHRAddress, callInfo)
+	mock.lockLoginWithPHRAddress.Unlock()
+	return mock.LoginWithPHRAddressFunc(ctx, headers, req, opts...)
+}
+
+// LoginWithPHRAddressCalls gets all the calls that were made to LoginWithPHRAddress.
+// Check the length with:
+//
+//	len(mockedLoginAPI.LoginWithPHRAddressCalls())
+func (mock *LoginAPIMock) LoginWithPHRAddressCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     *login.PhrAddressLoginRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *login.PhrAddressLoginRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockLoginWithPHRAddress.RLock()
+	calls = mock.calls.LoginWithPHRAddress
+	mock.lockLoginWithPHRAddress.RUnlock()
+	return calls
+}
+
+// Ensure, that RegistrationAPIMock does implement abdm.RegistrationAPI.
+// If this is not the case, regenerate this file with moq.
+var _ abdm.RegistrationAPI = &RegistrationAPIMock{}
+
+// RegistrationAPIMock is a mock implementation of abdm.RegistrationAPI.
+//
+//	func TestSomethingThatUsesRegistrationAPI(t *testing.T) {
+//
+//		// make and configure a mocked abdm.RegistrationAPI
+//		mockedRegistrationAPI := &RegistrationAPIMock{
+//			AadhaarCreatePHRFunc: func(ctx context.Context, headers interfaces.Headers, req registration.CreateRequest, opts ...interfaces.RequestOption) (*registration.CreateResponse, error) {
+//				panic("mock out the AadhaarCreatePHR method")
+//			},
+//			AadhaarInitFunc: func(ctx context.Context, headers interfaces.Headers, req registration.InitRequest, opts ...interfaces.RequestOption) (*registration.InitResponse, error) {
+//				panic("mock out the AadhaarInit method")
+//			},
+//			AadhaarMobileResendFunc: func(ctx context.Context, headers interfaces.Headers, oid string, req registration.MobileResendRequest, opts ...interfaces.RequestOption) (*registration.MobileResendResponse, error) {
+//				panic("mock out the AadhaarMobileResend method")
+//			},
+//			AadhaarMobileVerifyFunc: func(ctx context.Context, headers interfaces.Headers, oid string, req registration.MobileVerifyRequest, opts ...interfaces.RequestOption) (*registration.MobileVerifyResponse, error) {
+//				panic("mock out the AadhaarMobileVerify method")
+//			},
+//			AadhaarResendFunc: func(ctx context.Context, headers interfaces.Headers, req registration.ResendRequest, opts ...interfaces.RequestOption) (*registration.ResendResponse, error) {
+//				panic("mock out the AadhaarResend method")
+//			},
+//			AadhaarVerifyFunc: func(ctx context.Context, headers interfaces.Headers, req registration.VerifyRequest, opts ...interfaces.RequestOption) (*registration.VerifyResponse, error) {
+//				panic("mock out the AadhaarVerify method")
+//			},
+//			CheckAbhaAddressExistsFunc: func(ctx context.Context, headers interfaces.Headers, req registration.DoesHealthIdExistRequest, opts ...interfaces.RequestOption) (*registration.DoesHealthIdExistResponse, error) {
+//				panic("mock out the CheckAbhaAddressExists method")
+//			},
+//			GetPincodeDetailsFunc: func(ctx context.Context, headers interfaces.Headers, pincode string, opts ...interfaces.RequestOption) (*registration.PincodeData, error) {
+//				panic("mock out the GetPincodeDetails method")
+//			},
+//			MobileCreatePHRFunc: func(ctx context.Context, headers interfaces.Headers, req registration.MobileCreateRequest, opts ...interfaces.RequestOption) (*registration.MobileCreateResponse, error) {
+//				panic("mock out the MobileCreatePHR method")
+//			},
+//			MobileInitFunc: func(ctx context.Context, headers interfaces.Headers, req registration.MobileInitRequest, opts ...interfaces.RequestOption) (*registration.MobileInitResponse, error) {
+//				panic("mock out the MobileInit method")
+//			},
+//			MobileResendFunc: func(ctx context.Context, headers interfaces.Headers, req registration.MobileResendOTPRequest, opts ...interfaces.RequestOption) (*registration.MobileResendOTPResponse, error) {
+//				panic("mock out the MobileResend method")
+//			},
+//			MobileVerifyFunc: func(ctx context.Context, headers interfaces.Headers, req registration.MobileVerifyOTPRequest, opts ...interfaces.RequestOption) (*registration.MobileVerifyOTPResponse, error) {
+//				panic("mock out the MobileVerify method")
+//			},
+//			SuggestAbhaAddressFunc: func(ctx context.Context, headers interfaces.Headers, firstName string, middleName string, lastName string, dob string, transactionID string, opts ...interfaces.RequestOption) (*registration.SuggestHealthIdResponse, error) {
+//				panic("mock out the SuggestAbhaAddress method")
+//			},
+//		}
+//
+//		// use mockedRegistrationAPI in code that requires abdm.RegistrationAPI
+//		// and then make assertions.
+//
+//	}
+type RegistrationAPIMock struct {
+	// AadhaarCreatePHRFunc mocks the AadhaarCreatePHR method.
+	AadhaarCreatePHRFunc func(ctx context.Context, headers interfaces.Headers, req registration.CreateRequest, opts ...interfaces.RequestOption) (*registration.CreateResponse, error)
+
+	// AadhaarInitFunc mocks the AadhaarInit method.
+	AadhaarInitFunc func(ctx context.Context, headers interfaces.Headers, req registration.InitRequest, opts ...interfaces.RequestOption) (*registration.InitResponse, error)
+
+	// AadhaarMobileResendFunc mocks the AadhaarMobileResend method.
+	AadhaarMobileResendFunc func(ctx context.Context, headers interfaces.Headers, oid string, req registration.MobileResendRequest, opts ...interfaces.RequestOption) (*registration.MobileResendResponse, error)
+
+	// AadhaarMobileVerifyFunc mocks the AadhaarMobileVerify method.
+	AadhaarMobileVerifyFunc func(ctx context.Context, headers interfaces.Headers, oid string, req registration.MobileVerifyRequest, opts ...interfaces.RequestOption) (*registration.MobileVerifyResponse, error)
+
+	// AadhaarResendFunc mocks the AadhaarResend method.
+	AadhaarResendFunc func(ctx context.Context, headers interfaces.Headers, req registration.ResendRequest, opts ...interfaces.RequestOption) (*registration.ResendResponse, error)
+
+	// AadhaarVerifyFunc mocks the AadhaarVerify method.
+	AadhaarVerifyFunc func(ctx context.Context, headers interfaces.Headers, req registration.VerifyRequest, opts ...interfaces.RequestOption) (*registration.VerifyResponse, error)
+
+	// CheckAbhaAddressExistsFunc mocks the CheckAbhaAddressExists method.
+	CheckAbhaAddressExistsFunc func(ctx context.Context, headers interfaces.Headers, req registration.DoesHealthIdExistRequest, opts ...interfaces.RequestOption) (*registration.DoesHealthIdExistResponse, error)
+
+	// GetPincodeDetailsFunc mocks the GetPincodeDetails method.
+	GetPincodeDetailsFunc func(ctx context.Context, headers interfaces.Headers, pincode string, opts ...interfaces.RequestOption) (*registration.PincodeData, error)
+
+	// MobileCreatePHRFunc mocks the MobileCreatePHR method.
+	MobileCreatePHRFunc func(ctx context.Context, headers interfaces.Headers, req registration.MobileCreateRequest, opts ...interfaces.RequestOption) (*registration.MobileCreateResponse, error)
+
+	// MobileInitFunc mocks the MobileInit method.
+	MobileInitFunc func(ctx context.Context, headers interfaces.Headers, req registration.MobileInitRequest, opts ...interfaces.RequestOption) (*registration.MobileInitResponse, error)
+
+	// MobileResendFunc mocks the MobileResend method.
+	MobileResendFunc func(ctx context.Context, headers interfaces.Headers, req registration.MobileResendOTPRequest, opts ...interfaces.RequestOption) (*registration.MobileResendOTPResponse, error)
+
+	// MobileVerifyFunc mocks the MobileVerify method.
+	MobileVerifyFunc func(ctx context.Context, headers interfaces.Headers, req registration.MobileVerifyOTPRequest, opts ...interfaces.RequestOption) (*registration.MobileVerifyOTPResponse, error)
+
+	// SuggestAbhaAddressFunc mocks the SuggestAbhaAddress method.
+	SuggestAbhaAddressFunc func(ctx context.Context, headers interfaces.Headers, firstName string, middleName string, lastName string, dob string, transactionID string, opts ...interfaces.RequestOption) (*registration.SuggestHealthIdResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AadhaarCreatePHR holds details about calls to the AadhaarCreatePHR method.
+		AadhaarCreatePHR []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req registration.CreateRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// AadhaarInit holds details about calls to the AadhaarInit method.
+		AadhaarInit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req registration.InitRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// AadhaarMobileResend holds details about calls to the AadhaarMobileResend method.
+		AadhaarMobileResend []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Oid is the oid argument value.
+			Oid string
+			// Req is the req argument value.
+			Req registration.MobileResendRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// AadhaarMobileVerify holds details about calls to the AadhaarMobileVerify method.
+		AadhaarMobileVerify []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Oid is the oid argument value.
+			Oid string
+			// Req is the req argument value.
+			Req registration.MobileVerifyRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// AadhaarResend holds details about calls to the AadhaarResend method.
+		AadhaarResend []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req registration.ResendRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// AadhaarVerify holds details about calls to the AadhaarVerify method.
+		AadhaarVerify []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req registration.VerifyRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// CheckAbhaAddressExists holds details about calls to the CheckAbhaAddressExists method.
+		CheckAbhaAddressExists []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req registration.DoesHealthIdExistRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// GetPincodeDetails holds details about calls to the GetPincodeDetails method.
+		GetPincodeDetails []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Pincode is the pincode argument value.
+			Pincode string
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// MobileCreatePHR holds details about calls to the MobileCreatePHR method.
+		MobileCreatePHR []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req registration.MobileCreateRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// MobileInit holds details about calls to the MobileInit method.
+		MobileInit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req registration.MobileInitRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// MobileResend holds details about calls to the MobileResend method.
+		MobileResend []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req registration.MobileResendOTPRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// MobileVerify holds details about calls to the MobileVerify method.
+		MobileVerify []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req registration.MobileVerifyOTPRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// SuggestAbhaAddress holds details about calls to the SuggestAbhaAddress method.
+		SuggestAbhaAddress []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// FirstName is the firstName argument value.
+			FirstName string
+			// MiddleName is the middleName argument value.
+			MiddleName string
+			// LastName is the lastName argument value.
+			LastName string
+			// Dob is the dob argument value.
+			Dob string
+			// TransactionID is the transactionID argument value.
+			TransactionID string
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+	}
+	lockAadhaarCreatePHR       sync.RWMutex
+	lockAadhaarInit            sync.RWMutex
+	lockAadhaarMobileResend    sync.RWMutex
+	lockAadhaarMobileVerify    sync.RWMutex
+	lockAadhaarResend          sync.RWMutex
+	lockAadhaarVerify          sync.RWMutex
+	lockCheckAbhaAddressExists sync.RWMutex
+	lockGetPincodeDetails      sync.RWMutex
+	lockMobileCreatePHR        sync.RWMutex
+	lockMobileInit             sync.RWMutex
+	lockMobileResend           sync.RWMutex
+	lockMobileVerify           sync.RWMutex
+	lockSuggestAbhaAddress     sync.RWMutex
+}
+
+// AadhaarCreatePHR calls AadhaarCreatePHRFunc.
+func (mock *RegistrationAPIMock) AadhaarCreatePHR(ctx context.Context, headers interfaces.Headers, req registration.CreateRequest, opts ...interfaces.RequestOption) (*registration.CreateResponse, error) {
+	if mock.AadhaarCreatePHRFunc == nil {
+		panic("RegistrationAPIMock.AadhaarCreatePHRFunc: method is nil but RegistrationAPI.AadhaarCreatePHR was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.CreateRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockAadhaarCreatePHR.Lock()
+	mock.calls.AadhaarCreatePHR = append(mock.calls.AadhaarCreatePHR, callInfo)
+	mock.lockAadhaarCreatePHR.Unlock()
+	return mock.AadhaarCreatePHRFunc(ctx, headers, req, opts...)
+}
+
+// AadhaarCreatePHRCalls gets all the calls that were made to AadhaarCreatePHR.
+// Check the length with:
+//
+//	len(mockedRegistrationAPI.AadhaarCreatePHRCalls())
+func (mock *RegistrationAPIMock) AadhaarCreatePHRCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     registration.CreateRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.CreateRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockAadhaarCreatePHR.RLock()
+	calls = mock.calls.AadhaarCreatePHR
+	mock.lockAadhaarCreatePHR.RUnlock()
+	return calls
+}
+
+// AadhaarInit calls AadhaarInitFunc.
+func (mock *RegistrationAPIMock) AadhaarInit(ctx context.Context, headers interfaces.Headers, req registration.InitRequest, opts ...interfaces.RequestOption) (*registration.InitResponse, error) {
+	if mock.AadhaarInitFunc == nil {
+		panic("RegistrationAPIMock.AadhaarInitFunc: method is nil but RegistrationAPI.AadhaarInit was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.InitRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockAadhaarInit.Lock()
+	mock.calls.AadhaarInit = append(mock.calls.AadhaarInit, callInfo)
+	mock.lockAadhaarInit.Unlock()
+	return mock.AadhaarInitFunc(ctx, headers, req, opts...)
+}
+
+// AadhaarInitCalls gets all the calls that were made to AadhaarInit.
+// Check the length with:
+//
+//	len(mockedRegistrationAPI.AadhaarInitCalls())
+func (mock *RegistrationAPIMock) AadhaarInitCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     registration.InitRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.InitRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockAadhaarInit.RLock()
+	calls = mock.calls.AadhaarInit
+	mock.lockAadhaarInit.RUnlock()
+	return calls
+}
+
+// AadhaarMobileResend calls AadhaarMobileResendFunc.
+func (mock *RegistrationAPIMock) AadhaarMobileResend(ctx context.Context, headers interfaces.Headers, oid string, req registration.MobileResendRequest, opts ...interfaces.RequestOption) (*registration.MobileResendResponse, error) {
+	if mock.AadhaarMobileResendFunc == nil {
+		panic("RegistrationAPIMock.AadhaarMobileResendFunc: method is nil but RegistrationAPI.AadhaarMobileResend was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Oid     string
+		Req     registration.MobileResendRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Oid:     oid,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockAadhaarMobileResend.Lock()
+	mock.calls.AadhaarMobileResend = append(mock.calls.AadhaarMobileResend, callInfo)
+	mock.lockAadhaarMobileResend.Unlock()
+	return mock.AadhaarMobileResendFunc(ctx, headers, oid, req, opts...)
+}
+
+// AadhaarMobileResendCalls gets all the calls that were made to AadhaarMobileResend.
+// Check the length with:
+//
+//	len(mockedRegistrationAPI.AadhaarMobileResendCalls())
+func (mock *RegistrationAPIMock) AadhaarMobileResendCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Oid     string
+	Req     registration.MobileResendRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Oid     string
+		Req     registration.MobileResendRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockAadhaarMobileResend.RLock()
+	calls = mock.calls.AadhaarMobileResend
+	mock.lockAadhaarMobileResend.RUnlock()
+	return calls
+}
+
+// AadhaarMobileVerify calls AadhaarMobileVerifyFunc.
+func (mock *RegistrationAPIMock) AadhaarMobileVerify(ctx context.Context, headers interfaces.Headers, oid string, req registration.MobileVerifyRequest, opts ...interfaces.RequestOption) (*registration.MobileVerifyResponse, error) {
+	if mock.AadhaarMobileVerifyFunc == nil {
+		panic("RegistrationAPIMock.AadhaarMobileVerifyFunc: method is nil but RegistrationAPI.AadhaarMobileVerify was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Oid     string
+		Req     registration.MobileVerifyRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Oid:     oid,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockAadhaarMobileVerify.Lock()
+	mock.calls.AadhaarMobileVerify = append(mock.calls.AadhaarMobileVerify, callInfo)
+	mock.lockAadhaarMobileVerify.Unlock()
+	return mock.AadhaarMobileVerifyFunc(ctx, headers, oid, req, opts...)
+}
+
+// AadhaarMobileVerifyCalls gets all the calls that were made to AadhaarMobileVerify.
+// Check the length with:
+//
+//	len(mockedRegistrationAPI.AadhaarMobileVerifyCalls())
+func (mock *RegistrationAPIMock) AadhaarMobileVerifyCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Oid     string
+	Req     registration.MobileVerifyRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Oid     string
+		Req     registration.MobileVerifyRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockAadhaarMobileVerify.RLock()
+	calls = mock.calls.AadhaarMobileVerify
+	mock.lockAadhaarMobileVerify.RUnlock()
+	return calls
+}
+
+// AadhaarResend calls AadhaarResendFunc.
+func (mock *RegistrationAPIMock) AadhaarResend(ctx context.Context, headers interfaces.Headers, req registration.ResendRequest, opts ...interfaces.RequestOption) (*registration.ResendResponse, error) {
+	if mock.AadhaarResendFunc == nil {
+		panic("RegistrationAPIMock.AadhaarResendFunc: method is nil but RegistrationAPI.AadhaarResend was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.ResendRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockAadhaarResend.Lock()
+	mock.calls.AadhaarResend = append(mock.calls.AadhaarResend, callInfo)
+	mock.lockAadhaarResend.Unlock()
+	return mock.AadhaarResendFunc(ctx, headers, req, opts...)
+}
+
+// AadhaarResendCalls gets all the calls that were made to AadhaarResend.
+// Check the length with:
+//
+//	len(mockedRegistrationAPI.AadhaarResendCalls())
+func (mock *RegistrationAPIMock) AadhaarResendCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     registration.ResendRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.ResendRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockAadhaarResend.RLock()
+	calls = mock.calls.AadhaarResend
+	mock.lockAadhaarResend.RUnlock()
+	return calls
+}
+
+// AadhaarVerify calls AadhaarVerifyFunc.
+func (mock *RegistrationAPIMock) AadhaarVerify(ctx context.Context, headers interfaces.Headers, req registration.VerifyRequest, opts ...interfaces.RequestOption) (*registration.VerifyResponse, error) {
+	if mock.AadhaarVerifyFunc == nil {
+		panic("RegistrationAPIMock.AadhaarVerifyFunc: method is nil but RegistrationAPI.AadhaarVerify was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.VerifyRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockAadhaarVerify.Lock()
+	mock.calls.AadhaarVerify = append(mock.calls.AadhaarVerify, callInfo)
+	mock.lockAadhaarVerify.Unlock()
+	return mock.AadhaarVerifyFunc(ctx, headers, req, opts...)
+}
+
+// AadhaarVerifyCalls gets all the calls that were made to AadhaarVerify.
+// Check the length with:
+//
+//	len(mockedRegistrationAPI.AadhaarVerifyCalls())
+func (mock *RegistrationAPIMock) AadhaarVerifyCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     registration.VerifyRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.VerifyRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockAadhaarVerify.RLock()
+	calls = mock.calls.AadhaarVerify
+	mock.lockAadhaarVerify.RUnlock()
+	return calls
+}
+
+// CheckAbhaAddressExists calls CheckAbhaAddressExistsFunc.
+func (mock *RegistrationAPIMock) CheckAbhaAddressExists(ctx context.Context, headers interfaces.Headers, req registration.DoesHealthIdExistRequest, opts ...interfaces.RequestOption) (*registration.DoesHealthIdExistResponse, error) {
+	if mock.CheckAbhaAddressExistsFunc == nil {
+		panic("RegistrationAPIMock.CheckAbhaAddressExistsFunc: method is nil but RegistrationAPI.CheckAbhaAddressExists was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.DoesHealthIdExistRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockCheckAbhaAddressExists.Lock()
+	mock.calls.CheckAbhaAddressExists = append(mock.calls.CheckAbhaAddressExists, callInfo)
+	mock.lockCheckAbhaAddressExists.Unlock()
+	return mock.CheckAbhaAddressExistsFunc(ctx, headers, req, opts...)
+}
+
+// CheckAbhaAddressExistsCalls gets all the calls that were made to CheckAbhaAddressExists.
+// Check the length with:
+//
+//	len(mockedRegistrationAPI.CheckAbhaAddressExistsCalls())
+func (mock *RegistrationAPIMock) CheckAbhaAddressExistsCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     registration.DoesHealthIdExistRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.DoesHealthIdExistRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockCheckAbhaAddressExists.RLock()
+	calls = mock.calls.CheckAbhaAddressExists
+	mock.lockCheckAbhaAddressExists.RUnlock()
+	return calls
+}
+
+// GetPincodeDetails calls GetPincodeDetailsFunc.
+func (mock *RegistrationAPIMock) GetPincodeDetails(ctx context.Context, headers interfaces.Headers, pincode string, opts ...interfaces.RequestOption) (*registration.PincodeData, error) {
+	if mock.GetPincodeDetailsFunc == nil {
+		panic("RegistrationAPIMock.GetPincodeDetailsFunc: method is nil but RegistrationAPI.GetPincodeDetails was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Pincode string
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Pincode: pincode,
+		Opts:    opts,
+	}
+	mock.lockGetPincodeDetails.Lock()
+	mock.calls.GetPincodeDetails = append(mock.calls.GetPincodeDetails, callInfo)
+	mock.lockGetPincodeDetails.Unlock()
+	return mock.GetPincodeDetailsFunc(ctx, headers, pincode, opts...)
+}
+
+// GetPincodeDetailsCalls gets all the calls that were made to GetPincodeDetails.
+// Check the length with:
+//
+//	len(mockedRegistrationAPI.GetPincodeDetailsCalls())
+func (mock *RegistrationAPIMock) GetPincodeDetailsCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Pincode string
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Pincode string
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockGetPincodeDetails.RLock()
+	calls = mock.calls.GetPincodeDetails
+	mock.lockGetPincodeDetails.RUnlock()
+	return calls
+}
+
+// MobileCreatePHR calls MobileCreatePHRFunc.
+func (mock *RegistrationAPIMock) MobileCreatePHR(ctx context.Context, headers interfaces.Headers, req registration.MobileCreateRequest, opts ...interfaces.RequestOption) (*registration.MobileCreateResponse, error) {
+	if mock.MobileCreatePHRFunc == nil {
+		panic("RegistrationAPIMock.MobileCreatePHRFunc: method is nil but RegistrationAPI.MobileCreatePHR was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.MobileCreateRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockMobileCreatePHR.Lock()
+	mock.calls.MobileCreatePHR = append(mock.calls.MobileCreatePHR, callInfo)
+	mock.lockMobileCreatePHR.Unlock()
+	return mock.MobileCreatePHRFunc(ctx, headers, req, opts...)
+}
+
+// MobileCreatePHRCalls gets all the calls that were made to MobileCreatePHR.
+// Check the length with:
+//
+//	len(mockedRegistrationAPI.MobileCreatePHRCalls())
+func (mock *RegistrationAPIMock) MobileCreatePHRCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     registration.MobileCreateRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.MobileCreateRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockMobileCreatePHR.RLock()
+	calls = mock.calls.MobileCreatePHR
+	mock.lockMobileCreatePHR.RUnlock()
+	return calls
+}
+
+// MobileInit calls MobileInitFunc.
+func (mock *RegistrationAPIMock) MobileInit(ctx context.Context, headers interfaces.Headers, req registration.MobileInitRequest, opts ...interfaces.RequestOption) (*registration.MobileInitResponse, error) {
+	if mock.MobileInitFunc == nil {
+		panic("RegistrationAPIMock.MobileInitFunc: method is nil but RegistrationAPI.MobileInit was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.MobileInitRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockMobileInit.Lock()
+	mock.calls.MobileInit = append(mock.calls.MobileInit, callInfo)
+	mock.lockMobileInit.Unlock()
+	return mock.MobileInitFunc(ctx, headers, req, opts...)
+}
+
+// MobileInitCalls gets all the calls that were made to MobileInit.
+// Check the length with:
+//
+//	len(mockedRegistrationAPI.MobileInitCalls())
+func (mock *RegistrationAPIMock) MobileInitCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     registration.MobileInitRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.MobileInitRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockMobileInit.RLock()
+	calls = mock.calls.MobileInit
+	mock.lockMobileInit.RUnlock()
+	return calls
+}
+
+// MobileResend calls MobileResendFunc.
+func (mock *RegistrationAPIMock) MobileResend(ctx context.Context, headers interfaces.Headers, req registration.MobileResendOTPRequest, opts ...interfaces.RequestOption) (*registration.MobileResendOTPResponse, error) {
+	if mock.MobileResendFunc == nil {
+		panic("RegistrationAPIMock.MobileResendFunc: method is nil but RegistrationAPI.MobileResend was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.MobileResendOTPRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockMobileResend.Lock()
+	mock.calls.MobileResend = append(mock.calls.MobileResend, callInfo)
+	mock.lockMobileResend.Unlock()
+	return mock.MobileResendFunc(ctx, headers, req, opts...)
+}
+
+// MobileResendCalls gets all the calls that were made to MobileResend.
+// Check the length with:
+//
+//	len(mockedRegistrationAPI.MobileResendCalls())
+func (mock *RegistrationAPIMock) MobileResendCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     registration.MobileResendOTPRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.MobileResendOTPRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockMobileResend.RLock()
+	calls = mock.calls.MobileResend
+	mock.lockMobileResend.RUnlock()
+	return calls
+}
+
+// MobileVerify calls MobileVerifyFunc.
+func (mock *RegistrationAPIMock) MobileVerify(ctx context.Context, headers interfaces.Headers, req registration.MobileVerifyOTPRequest, opts ...interfaces.RequestOption) (*registration.MobileVerifyOTPResponse, error) {
+	if mock.MobileVerifyFunc == nil {
+		panic("RegistrationAPIMock.MobileVerifyFunc: method is nil but RegistrationAPI.MobileVerify was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.MobileVerifyOTPRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockMobileVerify.Lock()
+	mock.calls.MobileVerify = append(mock.calls.MobileVerify, callInfo)
+	mock.lockMobileVerify.Unlock()
+	return mock.MobileVerifyFunc(ctx, headers, req, opts...)
+}
+
+// MobileVerifyCalls gets all the calls that were made to MobileVerify.
+// Check the length with:
+//
+//	len(mockedRegistrationAPI.MobileVerifyCalls())
+func (mock *RegistrationAPIMock) MobileVerifyCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     registration.MobileVerifyOTPRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     registration.MobileVerifyOTPRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockMobileVerify.RLock()
+	calls = mock.calls.MobileVerify
+	mock.lockMobileVerify.RUnlock()
+	return calls
+}
+
+// SuggestAbhaAddress calls SuggestAbhaAddressFunc.
+func (mock *RegistrationAPIMock) SuggestAbhaAddress(ctx context.Context, headers interfaces.Headers, firstName string, middleName string, lastName string, dob string, transactionID string, opts ...interfaces.RequestOption) (*registration.SuggestHealthIdResponse, error) {
+	if mock.SuggestAbhaAddressFunc == nil {
+		panic("RegistrationAPIMock.SuggestAbhaAddressFunc: method is nil but RegistrationAPI.SuggestAbhaAddress was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Headers       interfaces.Headers
+		FirstName     string
+		MiddleName    string
+		LastName      string
+		Dob           string
+		TransactionID string
+		Opts          []interfaces.RequestOption
+	}{
+		Ctx:           ctx,
+		Headers:       headers,
+		FirstName:     firstName,
+		MiddleName:    middleName,
+		LastName:      lastName,
+		Dob:           dob,
+		TransactionID: transactionID,
+		Opts:          opts,
+	}
+	mock.lockSuggestAbhaAddress.Lock()
+	mock.calls.SuggestAbhaAddress = append(mock.calls.SuggestAbhaAddress, callInfo)
+	mock.lockSuggestAbhaAddress.Unlock()
+	return mock.SuggestAbhaAddressFunc(ctx, headers, firstName, middleName, lastName, dob, transactionID, opts...)
+}
+
+// SuggestAbhaAddressCalls gets all the calls that were made to SuggestAbhaAddress.
+// Check the length with:
+//
+//	len(mockedRegistrationAPI.SuggestAbhaAddressCalls())
+func (mock *RegistrationAPIMock) SuggestAbhaAddressCalls() []struct {
+	Ctx           context.Context
+	Headers       interfaces.Headers
+	FirstName     string
+	MiddleName    string
+	LastName      string
+	Dob           string
+	TransactionID string
+	Opts          []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Headers       interfaces.Headers
+		FirstName     string
+		MiddleName    string
+		LastName      string
+		Dob           string
+		TransactionID string
+		Opts          []interfaces.RequestOption
+	}
+	mock.lockSuggestAbhaAddress.RLock()
+	calls = mock.calls.SuggestAbhaAddress
+	mock.lockSuggestAbhaAddress.RUnlock()
+	return calls
+}
+
+// Ensure, that ProfileAPIMock does implement abdm.ProfileAPI.
+// If this is not the case, regenerate this file with moq.
+var _ abdm.ProfileAPI = &ProfileAPIMock{}
+
+// ProfileAPIMock is a mock implementation of abdm.ProfileAPI.
+//
+//	func TestSomethingThatUsesProfileAPI(t *testing.T) {
+//
+//		// make and configure a mocked abdm.ProfileAPI
+//		mockedProfileAPI := &ProfileAPIMock{
+//			DeleteProfileFunc: func(ctx context.Context, headers interfaces.Headers, oid string, opts ...interfaces.RequestOption) error {
+//				panic("mock out the DeleteProfile method")
+//			},
+//			GetAssetCardFunc: func(ctx context.Context, headers interfaces.Headers, req *profile.AssetRequest, opts ...interfaces.RequestOption) (*profile.AssetCardResponse, error) {
+//				panic("mock out the GetAssetCard method")
+//			},
+//			GetAssetQRFunc: func(ctx context.Context, headers interfaces.Headers, req *profile.AssetRequest, opts ...interfaces.RequestOption) (*profile.AssetQRResponse, error) {
+//				panic("mock out the GetAssetQR method")
+//			},
+//			GetProfileFunc: func(ctx context.Context, headers interfaces.Headers, opts ...interfaces.RequestOption) (*profile.ProfileResponse, error) {
+//				panic("mock out the GetProfile method")
+//			},
+//			KYCInitFunc: func(ctx context.Context, headers interfaces.Headers, req *profile.KYCInitRequest, opts ...interfaces.RequestOption) (*profile.KYCInitResponse, error) {
+//				panic("mock out the KYCInit method")
+//			},
+//			KYCResendFunc: func(ctx context.Context, headers interfaces.Headers, req *profile.KYCResendRequest, opts ...interfaces.RequestOption) (*profile.KYCResendResponse, error) {
+//				panic("mock out the KYCResend method")
+//			},
+//			KYCVerifyFunc: func(ctx context.Context, headers interfaces.Headers, req *profile.KYCVerifyRequest, opts ...interfaces.RequestOption) (*profile.KYCVerifyResponse, error) {
+//				panic("mock out the KYCVerify method")
+//			},
+//			SessionInitFunc: func(ctx context.Context, headers interfaces.Headers, req *profile.SessionInitRequest, opts ...interfaces.RequestOption) (*profile.SessionInitResponse, error) {
+//				panic("mock out the SessionInit method")
+//			},
+//			SessionVerifyFunc: func(ctx context.Context, headers interfaces.Headers, req *profile.SessionVerifyRequest, opts ...interfaces.RequestOption) (*profile.SessionVerifyResponse, error) {
+//				panic("mock out the SessionVerify method")
+//			},
+//			UpdateProfileFunc: func(ctx context.Context, headers interfaces.Headers, req *profile.UpdateProfileRequest, opts ...interfaces.RequestOption) error {
+//				panic("mock out the UpdateProfile method")
+//			},
+//		}
+//
+//		// use mockedProfileAPI in code that requires abdm.ProfileAPI
+//		// and then make assertions.
+//
+//	}
+type ProfileAPIMock struct {
+	// DeleteProfileFunc mocks the DeleteProfile method.
+	DeleteProfileFunc func(ctx context.Context, headers interfaces.Headers, oid string, opts ...interfaces.RequestOption) error
+
+	// GetAssetCardFunc mocks the GetAssetCard method.
+	GetAssetCardFunc func(ctx context.Context, headers interfaces.Headers, req *profile.AssetRequest, opts ...interfaces.RequestOption) (*profile.AssetCardResponse, error)
+
+	// GetAssetQRFunc mocks the GetAssetQR method.
+	GetAssetQRFunc func(ctx context.Context, headers interfaces.Headers, req *profile.AssetRequest, opts ...interfaces.RequestOption) (*profile.AssetQRResponse, error)
+
+	// GetProfileFunc mocks the GetProfile method.
+	GetProfileFunc func(ctx context.Context, headers interfaces.Headers, opts ...interfaces.RequestOption) (*profile.ProfileResponse, error)
+
+	// KYCInitFunc mocks the KYCInit method.
+	KYCInitFunc func(ctx context.Context, headers interfaces.Headers, req *profile.KYCInitRequest, opts ...interfaces.RequestOption) (*profile.KYCInitResponse, error)
+
+	// KYCResendFunc mocks the KYCResend method.
+	KYCResendFunc func(ctx context.Context, headers interfaces.Headers, req *profile.KYCResendRequest, opts ...interfaces.RequestOption) (*profile.KYCResendResponse, error)
+
+	// KYCVerifyFunc mocks the KYCVerify method.
+	KYCVerifyFunc func(ctx context.Context, headers interfaces.Headers, req *profile.KYCVerifyRequest, opts ...interfaces.RequestOption) (*profile.KYCVerifyResponse, error)
+
+	// SessionInitFunc mocks the SessionInit method.
+	SessionInitFunc func(ctx context.Context, headers interfaces.Headers, req *profile.SessionInitRequest, opts ...interfaces.RequestOption) (*profile.SessionInitResponse, error)
+
+	// SessionVerifyFunc mocks the SessionVerify method.
+	SessionVerifyFunc func(ctx context.Context, headers interfaces.Headers, req *profile.SessionVerifyRequest, opts ...interfaces.RequestOption) (*profile.SessionVerifyResponse, error)
+
+	// UpdateProfileFunc mocks the UpdateProfile method.
+	UpdateProfileFunc func(ctx context.Context, headers interfaces.Headers, req *profile.UpdateProfileRequest, opts ...interfaces.RequestOption) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteProfile holds details about calls to the DeleteProfile method.
+		DeleteProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Oid is the oid argument value.
+			Oid string
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// GetAssetCard holds details about calls to the GetAssetCard method.
+		GetAssetCard []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req *profile.AssetRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// GetAssetQR holds details about calls to the GetAssetQR method.
+		GetAssetQR []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req *profile.AssetRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// GetProfile holds details about calls to the GetProfile method.
+		GetProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// KYCInit holds details about calls to the KYCInit method.
+		KYCInit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req *profile.KYCInitRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// KYCResend holds details about calls to the KYCResend method.
+		KYCResend []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req *profile.KYCResendRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// KYCVerify holds details about calls to the KYCVerify method.
+		KYCVerify []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req *profile.KYCVerifyRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// SessionInit holds details about calls to the SessionInit method.
+		SessionInit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req *profile.SessionInitRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// SessionVerify holds details about calls to the SessionVerify method.
+		SessionVerify []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req *profile.SessionVerifyRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// UpdateProfile holds details about calls to the UpdateProfile method.
+		UpdateProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Headers is the headers argument value.
+			Headers interfaces.Headers
+			// Req is the req argument value.
+			Req *profile.UpdateProfileRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+	}
+	lockDeleteProfile sync.RWMutex
+	lockGetAssetCard  sync.RWMutex
+	lockGetAssetQR    sync.RWMutex
+	lockGetProfile    sync.RWMutex
+	lockKYCInit       sync.RWMutex
+	lockKYCResend     sync.RWMutex
+	lockKYCVerify     sync.RWMutex
+	lockSessionInit   sync.RWMutex
+	lockSessionVerify sync.RWMutex
+	lockUpdateProfile sync.RWMutex
+}
+
+// DeleteProfile calls DeleteProfileFunc.
+func (mock *ProfileAPIMock) DeleteProfile(ctx context.Context, headers interfaces.Headers, oid string, opts ...interfaces.RequestOption) error {
+	if mock.DeleteProfileFunc == nil {
+		panic("ProfileAPIMock.DeleteProfileFunc: method is nil but ProfileAPI.DeleteProfile was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Oid     string
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Oid:     oid,
+		Opts:    opts,
+	}
+	mock.lockDeleteProfile.Lock()
+	mock.calls.DeleteProfile = append(mock.calls.DeleteProfile, callInfo)
+	mock.lockDeleteProfile.Unlock()
+	return mock.DeleteProfileFunc(ctx, headers, oid, opts...)
+}
+
+// DeleteProfileCalls gets all the calls that were made to DeleteProfile.
+// Check the length with:
+//
+//	len(mockedProfileAPI.DeleteProfileCalls())
+func (mock *ProfileAPIMock) DeleteProfileCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Oid     string
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Oid     string
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockDeleteProfile.RLock()
+	calls = mock.calls.DeleteProfile
+	mock.lockDeleteProfile.RUnlock()
+	return calls
+}
+
+// GetAssetCard calls GetAssetCardFunc.
+func (mock *ProfileAPIMock) GetAssetCard(ctx context.Context, headers interfaces.Headers, req *profile.AssetRequest, opts ...interfaces.RequestOption) (*profile.AssetCardResponse, error) {
+	if mock.GetAssetCardFunc == nil {
+		panic("ProfileAPIMock.GetAssetCardFunc: method is nil but ProfileAPI.GetAssetCard was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.AssetRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockGetAssetCard.Lock()
+	mock.calls.GetAssetCard = append(mock.calls.GetAssetCard, callInfo)
+	mock.lockGetAssetCard.Unlock()
+	return mock.GetAssetCardFunc(ctx, headers, req, opts...)
+}
+
+// GetAssetCardCalls gets all the calls that were made to GetAssetCard.
+// Check the length with:
+//
+//	len(mockedProfileAPI.GetAssetCardCalls())
+func (mock *ProfileAPIMock) GetAssetCardCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     *profile.AssetRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.AssetRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockGetAssetCard.RLock()
+	calls = mock.calls.GetAssetCard
+	mock.lockGetAssetCard.RUnlock()
+	return calls
+}
+
+// GetAssetQR calls GetAssetQRFunc.
+func (mock *ProfileAPIMock) GetAssetQR(ctx context.Context, headers interfaces.Headers, req *profile.AssetRequest, opts ...interfaces.RequestOption) (*profile.AssetQRResponse, error) {
+	if mock.GetAssetQRFunc == nil {
+		panic("ProfileAPIMock.GetAssetQRFunc: method is nil but ProfileAPI.GetAssetQR was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.AssetRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockGetAssetQR.Lock()
+	mock.calls.GetAssetQR = append(mock.calls.GetAssetQR, callInfo)
+	mock.lockGetAssetQR.Unlock()
+	return mock.GetAssetQRFunc(ctx, headers, req, opts...)
+}
+
+// GetAssetQRCalls gets all the calls that were made to GetAssetQR.
+// Check the length with:
+//
+//	len(mockedProfileAPI.GetAssetQRCalls())
+func (mock *ProfileAPIMock) GetAssetQRCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     *profile.AssetRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.AssetRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockGetAssetQR.RLock()
+	calls = mock.calls.GetAssetQR
+	mock.lockGetAssetQR.RUnlock()
+	return calls
+}
+
+// GetProfile calls GetProfileFunc.
+func (mock *ProfileAPIMock) GetProfile(ctx context.Context, headers interfaces.Headers, opts ...interfaces.RequestOption) (*profile.ProfileResponse, error) {
+	if mock.GetProfileFunc == nil {
+		panic("ProfileAPIMock.GetProfileFunc: method is nil but ProfileAPI.GetProfile was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Opts:    opts,
+	}
+	mock.lockGetProfile.Lock()
+	mock.calls.GetProfile = append(mock.calls.GetProfile, callInfo)
+	mock.lockGetProfile.Unlock()
+	return mock.GetProfileFunc(ctx, headers, opts...)
+}
+
+// GetProfileCalls gets all the calls that were made to GetProfile.
+// Check the length with:
+//
+//	len(mockedProfileAPI.GetProfileCalls())
+func (mock *ProfileAPIMock) GetProfileCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockGetProfile.RLock()
+	calls = mock.calls.GetProfile
+	mock.lockGetProfile.RUnlock()
+	return calls
+}
+
+// KYCInit calls KYCInitFunc.
+func (mock *ProfileAPIMock) KYCInit(ctx context.Context, headers interfaces.Headers, req *profile.KYCInitRequest, opts ...interfaces.RequestOption) (*profile.KYCInitResponse, error) {
+	if mock.KYCInitFunc == nil {
+		panic("ProfileAPIMock.KYCInitFunc: method is nil but ProfileAPI.KYCInit was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.KYCInitRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockKYCInit.Lock()
+	mock.calls.KYCInit = append(mock.calls.KYCInit, callInfo)
+	mock.lockKYCInit.Unlock()
+	return mock.KYCInitFunc(ctx, headers, req, opts...)
+}
+
+// KYCInitCalls gets all the calls that were made to KYCInit.
+// Check the length with:
+//
+//	len(mockedProfileAPI.KYCInitCalls())
+func (mock *ProfileAPIMock) KYCInitCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     *profile.KYCInitRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.KYCInitRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockKYCInit.RLock()
+	calls = mock.calls.KYCInit
+	mock.lockKYCInit.RUnlock()
+	return calls
+}
+
+// KYCResend calls KYCResendFunc.
+func (mock *ProfileAPIMock) KYCResend(ctx context.Context, headers interfaces.Headers, req *profile.KYCResendRequest, opts ...interfaces.RequestOption) (*profile.KYCResendResponse, error) {
+	if mock.KYCResendFunc == nil {
+		panic("ProfileAPIMock.KYCResendFunc: method is nil but ProfileAPI.KYCResend was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.KYCResendRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockKYCResend.Lock()
+	mock.calls.KYCResend = append(mock.calls.KYCResend, callInfo)
+	mock.lockKYCResend.Unlock()
+	return mock.KYCResendFunc(ctx, headers, req, opts...)
+}
+
+// KYCResendCalls gets all the calls that were made to KYCResend.
+// Check the length with:
+//
+//	len(mockedProfileAPI.KYCResendCalls())
+func (mock *ProfileAPIMock) KYCResendCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     *profile.KYCResendRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.KYCResendRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockKYCResend.RLock()
+	calls = mock.calls.KYCResend
+	mock.lockKYCResend.RUnlock()
+	return calls
+}
+
+// KYCVerify calls KYCVerifyFunc.
+func (mock *ProfileAPIMock) KYCVerify(ctx context.Context, headers interfaces.Headers, req *profile.KYCVerifyRequest, opts ...interfaces.RequestOption) (*profile.KYCVerifyResponse, error) {
+	if mock.KYCVerifyFunc == nil {
+		panic("ProfileAPIMock.KYCVerifyFunc: method is nil but ProfileAPI.KYCVerify was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.KYCVerifyRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockKYCVerify.Lock()
+	mock.calls.KYCVerify = append(mock.calls.KYCVerify, callInfo)
+	mock.lockKYCVerify.Unlock()
+	return mock.KYCVerifyFunc(ctx, headers, req, opts...)
+}
+
+// KYCVerifyCalls gets all the calls that were made to KYCVerify.
+// Check the length with:
+//
+//	len(mockedProfileAPI.KYCVerifyCalls())
+func (mock *ProfileAPIMock) KYCVerifyCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     *profile.KYCVerifyRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.KYCVerifyRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockKYCVerify.RLock()
+	calls = mock.calls.KYCVerify
+	mock.lockKYCVerify.RUnlock()
+	return calls
+}
+
+// SessionInit calls SessionInitFunc.
+func (mock *ProfileAPIMock) SessionInit(ctx context.Context, headers interfaces.Headers, req *profile.SessionInitRequest, opts ...interfaces.RequestOption) (*profile.SessionInitResponse, error) {
+	if mock.SessionInitFunc == nil {
+		panic("ProfileAPIMock.SessionInitFunc: method is nil but ProfileAPI.SessionInit was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.SessionInitRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockSessionInit.Lock()
+	mock.calls.SessionInit = append(mock.calls.SessionInit, callInfo)
+	mock.lockSessionInit.Unlock()
+	return mock.SessionInitFunc(ctx, headers, req, opts...)
+}
+
+// SessionInitCalls gets all the calls that were made to SessionInit.
+// Check the length with:
+//
+//	len(mockedProfileAPI.SessionInitCalls())
+func (mock *ProfileAPIMock) SessionInitCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     *profile.SessionInitRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.SessionInitRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockSessionInit.RLock()
+	calls = mock.calls.SessionInit
+	mock.lockSessionInit.RUnlock()
+	return calls
+}
+
+// SessionVerify calls SessionVerifyFunc.
+func (mock *ProfileAPIMock) SessionVerify(ctx context.Context, headers interfaces.Headers, req *profile.SessionVerifyRequest, opts ...interfaces.RequestOption) (*profile.SessionVerifyResponse, error) {
+	if mock.SessionVerifyFunc == nil {
+		panic("ProfileAPIMock.SessionVerifyFunc: method is nil but ProfileAPI.SessionVerify was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.SessionVerifyRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockSessionVerify.Lock()
+	mock.calls.SessionVerify = append(mock.calls.SessionVerify, callInfo)
+	mock.lockSessionVerify.Unlock()
+	return mock.SessionVerifyFunc(ctx, headers, req, opts...)
+}
+
+// SessionVerifyCalls gets all the calls that were made to SessionVerify.
+// Check the length with:
+//
+//	len(mockedProfileAPI.SessionVerifyCalls())
+func (mock *ProfileAPIMock) SessionVerifyCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     *profile.SessionVerifyRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.SessionVerifyRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockSessionVerify.RLock()
+	calls = mock.calls.SessionVerify
+	mock.lockSessionVerify.RUnlock()
+	return calls
+}
+
+// UpdateProfile calls UpdateProfileFunc.
+func (mock *ProfileAPIMock) UpdateProfile(ctx context.Context, headers interfaces.Headers, req *profile.UpdateProfileRequest, opts ...interfaces.RequestOption) error {
+	if mock.UpdateProfileFunc == nil {
+		panic("ProfileAPIMock.UpdateProfileFunc: method is nil but ProfileAPI.UpdateProfile was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.UpdateProfileRequest
+		Opts    []interfaces.RequestOption
+	}{
+		Ctx:     ctx,
+		Headers: headers,
+		Req:     req,
+		Opts:    opts,
+	}
+	mock.lockUpdateProfile.Lock()
+	mock.calls.UpdateProfile = append(mock.calls.UpdateProfile, callInfo)
+	mock.lockUpdateProfile.Unlock()
+	return mock.UpdateProfileFunc(ctx, headers, req, opts...)
+}
+
+// UpdateProfileCalls gets all the calls that were made to UpdateProfile.
+// Check the length with:
+//
+//	len(mockedProfileAPI.UpdateProfileCalls())
+func (mock *ProfileAPIMock) UpdateProfileCalls() []struct {
+	Ctx     context.Context
+	Headers interfaces.Headers
+	Req     *profile.UpdateProfileRequest
+	Opts    []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Headers interfaces.Headers
+		Req     *profile.UpdateProfileRequest
+		Opts    []interfaces.RequestOption
+	}
+	mock.lockUpdateProfile.RLock()
+	calls = mock.calls.UpdateProfile
+	mock.lockUpdateProfile.RUnlock()
+	return calls
+}
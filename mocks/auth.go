@@ -0,0 +1,257 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/eka-care/eka-sdk-go/auth"
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+	"sync"
+)
+
+// Ensure, that AuthAPIMock does implement auth.AuthAPI.
+// If this is not the case, regenerate this file with moq.
+var _ auth.AuthAPI = &AuthAPIMock{}
+
+// AuthAPIMock is a mock implementation of auth.AuthAPI.
+//
+//	func TestSomethingThatUsesAuthAPI(t *testing.T) {
+//
+//		// make and configure a mocked auth.AuthAPI
+//		mockedAuthAPI := &AuthAPIMock{
+//			ClientLoginFunc: func(ctx context.Context, req *auth.ClientLoginRequest, opts ...interfaces.RequestOption) (*auth.ClientLoginResponse, error) {
+//				panic("mock out the ClientLogin method")
+//			},
+//			LogoutFunc: func(ctx context.Context, req *auth.LogoutRequest, opts ...interfaces.RequestOption) error {
+//				panic("mock out the Logout method")
+//			},
+//			RefreshTokenFunc: func(ctx context.Context, req *auth.RefreshTokenRequest, opts ...interfaces.RequestOption) (*auth.RefreshTokenResponse, error) {
+//				panic("mock out the RefreshToken method")
+//			},
+//			RevokeFunc: func(ctx context.Context, req *auth.RevokeTokenRequest, opts ...interfaces.RequestOption) error {
+//				panic("mock out the Revoke method")
+//			},
+//		}
+//
+//		// use mockedAuthAPI in code that requires auth.AuthAPI
+//		// and then make assertions.
+//
+//	}
+type AuthAPIMock struct {
+	// ClientLoginFunc mocks the ClientLogin method.
+	ClientLoginFunc func(ctx context.Context, req *auth.ClientLoginRequest, opts ...interfaces.RequestOption) (*auth.ClientLoginResponse, error)
+
+	// LogoutFunc mocks the Logout method.
+	LogoutFunc func(ctx context.Context, req *auth.LogoutRequest, opts ...interfaces.RequestOption) error
+
+	// RefreshTokenFunc mocks the RefreshToken method.
+	RefreshTokenFunc func(ctx context.Context, req *auth.RefreshTokenRequest, opts ...interfaces.RequestOption) (*auth.RefreshTokenResponse, error)
+
+	// RevokeFunc mocks the Revoke method.
+	RevokeFunc func(ctx context.Context, req *auth.RevokeTokenRequest, opts ...interfaces.RequestOption) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ClientLogin holds details about calls to the ClientLogin method.
+		ClientLogin []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *auth.ClientLoginRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// Logout holds details about calls to the Logout method.
+		Logout []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *auth.LogoutRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// RefreshToken holds details about calls to the RefreshToken method.
+		RefreshToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *auth.RefreshTokenRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+		// Revoke holds details about calls to the Revoke method.
+		Revoke []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *auth.RevokeTokenRequest
+			// Opts is the opts argument value.
+			Opts []interfaces.RequestOption
+		}
+	}
+	lockClientLogin  sync.RWMutex
+	lockLogout       sync.RWMutex
+	lockRefreshToken sync.RWMutex
+	lockRevoke       sync.RWMutex
+}
+
+// ClientLogin calls ClientLoginFunc.
+func (mock *AuthAPIMock) ClientLogin(ctx context.Context, req *auth.ClientLoginRequest, opts ...interfaces.RequestOption) (*auth.ClientLoginResponse, error) {
+	if mock.ClientLoginFunc == nil {
+		panic("AuthAPIMock.ClientLoginFunc: method is nil but AuthAPI.ClientLogin was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Req  *auth.ClientLoginRequest
+		Opts []interfaces.RequestOption
+	}{
+		Ctx:  ctx,
+		Req:  req,
+		Opts: opts,
+	}
+	mock.lockClientLogin.Lock()
+	mock.calls.ClientLogin = append(mock.calls.ClientLogin, callInfo)
+	mock.lockClientLogin.Unlock()
+	return mock.ClientLoginFunc(ctx, req, opts...)
+}
+
+// ClientLoginCalls gets all the calls that were made to ClientLogin.
+// Check the length with:
+//
+//	len(mockedAuthAPI.ClientLoginCalls())
+func (mock *AuthAPIMock) ClientLoginCalls() []struct {
+	Ctx  context.Context
+	Req  *auth.ClientLoginRequest
+	Opts []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Req  *auth.ClientLoginRequest
+		Opts []interfaces.RequestOption
+	}
+	mock.lockClientLogin.RLock()
+	calls = mock.calls.ClientLogin
+	mock.lockClientLogin.RUnlock()
+	return calls
+}
+
+// Logout calls LogoutFunc.
+func (mock *AuthAPIMock) Logout(ctx context.Context, req *auth.LogoutRequest, opts ...interfaces.RequestOption) error {
+	if mock.LogoutFunc == nil {
+		panic("AuthAPIMock.LogoutFunc: method is nil but AuthAPI.Logout was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Req  *auth.LogoutRequest
+		Opts []interfaces.RequestOption
+	}{
+		Ctx:  ctx,
+		Req:  req,
+		Opts: opts,
+	}
+	mock.lockLogout.Lock()
+	mock.calls.Logout = append(mock.calls.Logout, callInfo)
+	mock.lockLogout.Unlock()
+	return mock.LogoutFunc(ctx, req, opts...)
+}
+
+// LogoutCalls gets all the calls that were made to Logout.
+// Check the length with:
+//
+//	len(mockedAuthAPI.LogoutCalls())
+func (mock *AuthAPIMock) LogoutCalls() []struct {
+	Ctx  context.Context
+	Req  *auth.LogoutRequest
+	Opts []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Req  *auth.LogoutRequest
+		Opts []interfaces.RequestOption
+	}
+	mock.lockLogout.RLock()
+	calls = mock.calls.Logout
+	mock.lockLogout.RUnlock()
+	return calls
+}
+
+// RefreshToken calls RefreshTokenFunc.
+func (mock *AuthAPIMock) RefreshToken(ctx context.Context, req *auth.RefreshTokenRequest, opts ...interfaces.RequestOption) (*auth.RefreshTokenResponse, error) {
+	if mock.RefreshTokenFunc == nil {
+		panic("AuthAPIMock.RefreshTokenFunc: method is nil but AuthAPI.RefreshToken was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Req  *auth.RefreshTokenRequest
+		Opts []interfaces.RequestOption
+	}{
+		Ctx:  ctx,
+		Req:  req,
+		Opts: opts,
+	}
+	mock.lockRefreshToken.Lock()
+	mock.calls.RefreshToken = append(mock.calls.RefreshToken, callInfo)
+	mock.lockRefreshToken.Unlock()
+	return mock.RefreshTokenFunc(ctx, req, opts...)
+}
+
+// RefreshTokenCalls gets all the calls that were made to RefreshToken.
+// Check the length with:
+//
+//	len(mockedAuthAPI.RefreshTokenCalls())
+func (mock *AuthAPIMock) RefreshTokenCalls() []struct {
+	Ctx  context.Context
+	Req  *auth.RefreshTokenRequest
+	Opts []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Req  *auth.RefreshTokenRequest
+		Opts []interfaces.RequestOption
+	}
+	mock.lockRefreshToken.RLock()
+	calls = mock.calls.RefreshToken
+	mock.lockRefreshToken.RUnlock()
+	return calls
+}
+
+// Revoke calls RevokeFunc.
+func (mock *AuthAPIMock) Revoke(ctx context.Context, req *auth.RevokeTokenRequest, opts ...interfaces.RequestOption) error {
+	if mock.RevokeFunc == nil {
+		panic("AuthAPIMock.RevokeFunc: method is nil but AuthAPI.Revoke was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Req  *auth.RevokeTokenRequest
+		Opts []interfaces.RequestOption
+	}{
+		Ctx:  ctx,
+		Req:  req,
+		Opts: opts,
+	}
+	mock.lockRevoke.Lock()
+	mock.calls.Revoke = append(mock.calls.Revoke, callInfo)
+	mock.lockRevoke.Unlock()
+	return mock.RevokeFunc(ctx, req, opts...)
+}
+
+// RevokeCalls gets all the calls that were made to Revoke.
+// Check the length with:
+//
+//	len(mockedAuthAPI.RevokeCalls())
+func (mock *AuthAPIMock) RevokeCalls() []struct {
+	Ctx  context.Context
+	Req  *auth.RevokeTokenRequest
+	Opts []interfaces.RequestOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Req  *auth.RevokeTokenRequest
+		Opts []interfaces.RequestOption
+	}
+	mock.lockRevoke.RLock()
+	calls = mock.calls.Revoke
+	mock.lockRevoke.RUnlock()
+	return calls
+}
@@ -0,0 +1,20 @@
+// Package mocks provides generated mock implementations of the SDK service
+// interfaces (abdm.LoginAPI, abdm.RegistrationAPI, abdm.ProfileAPI and
+// auth.AuthAPI) for unit tests that should not make HTTP calls.
+//
+// Each mock has a Func field per method and records its calls:
+//
+//	profileAPI := &mocks.ProfileAPIMock{
+//		GetProfileFunc: func(ctx context.Context, headers ekasdk.Headers, opts ...request.Option) (*profile.ProfileResponse, error) {
+//			return &profile.ProfileResponse{AbhaAddress: "test@abdm"}, nil
+//		},
+//	}
+//	client := abdm.NewClientFromServices(abdm.Services{Profile: profileAPI})
+//	// ... exercise code using client ...
+//	if len(profileAPI.GetProfileCalls()) != 1 {
+//		t.Fatal("expected one GetProfile call")
+//	}
+//
+// Calling a method whose Func field is nil panics. The mocks are regenerated
+// with go generate ./...
+package mocks
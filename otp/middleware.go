@@ -0,0 +1,174 @@
+package otp
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// confirmMobileOTP is the skip state of an Aadhaar verification that sent an
+// OTP to the mobile number entered by the user
+const confirmMobileOTP = "confirm_mobile_otp"
+
+// otpCall is the kind of an OTP endpoint
+type otpCall int
+
+const (
+	callNone otpCall = iota
+	callSend
+	callResend
+	callVerify
+)
+
+// classify returns the kind of OTP endpoint a request is for: ABDM paths
+// ending in /init send an OTP, /resend resend it and /verify check it
+func classify(req *http.Request) otpCall {
+	path := req.URL.Path
+	if req.Method != http.MethodPost || !strings.HasPrefix(path, "/abdm/") {
+		return callNone
+	}
+	switch {
+	case strings.HasSuffix(path, "/init"):
+		return callSend
+	case strings.HasSuffix(path, "/resend"):
+		return callResend
+	case strings.HasSuffix(path, "/verify"):
+		return callVerify
+	default:
+		return callNone
+	}
+}
+
+// otpBody holds the fields of OTP requests and responses the tracker reads
+type otpBody struct {
+	TxnID     string `json:"txn_id"`
+	SkipState string `json:"skip_state"`
+}
+
+// Middleware returns the middleware to install with ekasdk.WithMiddleware.
+// It rejects resends and verifications the policy does not allow before
+// they are sent, and records the outcome of every init, resend and verify
+// call. Verifications rejected by the gateway with a 4xx status count as
+// wrong attempts; 401, 403 and 429 responses, which reject the caller rather
+// than the OTP, 5xx responses and network errors are not counted.
+func (t *Tracker) Middleware() interfaces.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &trackerTransport{next: next, tracker: t}
+	}
+}
+
+// trackerTransport tracks OTP calls
+type trackerTransport struct {
+	next    http.RoundTripper
+	tracker *Tracker
+}
+
+func (t *trackerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	call := classify(req)
+	if call == callNone {
+		return t.next.RoundTrip(req)
+	}
+
+	txnID := requestTxnID(req)
+	switch call {
+	case callResend:
+		if err := t.tracker.CheckResend(txnID); err != nil {
+			return nil, err
+		}
+	case callVerify:
+		if err := t.tracker.CheckVerify(txnID); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		t.succeeded(call, txnID, resp)
+	case call == callVerify && wrongOTP(resp.StatusCode):
+		t.tracker.VerifyFailed(txnID)
+	}
+	return resp, nil
+}
+
+// wrongOTP reports whether a verify response status rejects the entered OTP
+func wrongOTP(status int) bool {
+	switch status {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusTooManyRequests:
+		return false
+	}
+	return status >= 400 && status < 500
+}
+
+// succeeded records a successful OTP call
+func (t *trackerTransport) succeeded(call otpCall, txnID string, resp *http.Response) {
+	body := responseBody(resp)
+	switch call {
+	case callSend:
+		if body.TxnID != "" {
+			t.tracker.Sent(body.TxnID)
+		}
+	case callResend:
+		if txnID != "" {
+			t.tracker.Resent(txnID)
+		}
+	case callVerify:
+		if txnID == "" {
+			return
+		}
+		t.tracker.Verified(txnID)
+		// A verified Aadhaar OTP may be followed by a mobile OTP in the same transaction
+		if body.SkipState == confirmMobileOTP {
+			t.tracker.Sent(firstNonEmpty(body.TxnID, txnID))
+		}
+	}
+}
+
+// requestTxnID returns the txn_id of a request body without consuming it
+func requestTxnID(req *http.Request) string {
+	if req.GetBody == nil {
+		return ""
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	var v otpBody
+	if err := json.NewDecoder(body).Decode(&v); err != nil {
+		return ""
+	}
+	return v.TxnID
+}
+
+// responseBody decodes the OTP fields of a response and replaces its body so
+// that callers can still read it
+func responseBody(resp *http.Response) otpBody {
+	var v otpBody
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err == nil {
+		_ = json.Unmarshal(data, &v)
+	}
+	return v
+}
+
+// firstNonEmpty returns the first of values that is not empty
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,279 @@
+// Package otp tracks the lifecycle of ABDM OTPs per transaction, so that
+// resend cooldowns, resend limits, verify attempt limits and expiry are
+// enforced locally and the UI can tell users when they may try again.
+//
+// Install the tracker's middleware and every init, resend and verify call is
+// tracked by txn_id; calls the gateway would reject fail early with an
+// *Error telling when a new OTP may be requested:
+//
+//	tracker := otp.NewTracker(otp.DefaultPolicy())
+//	client := ekasdk.New(ekasdk.WithMiddleware(tracker.Middleware()))
+//	...
+//	_, err := client.ABDM.Registration().AadhaarResend(ctx, headers, req)
+//	var otpErr *otp.Error
+//	if errors.As(err, &otpErr) && otpErr.ResendsLeft > 0 {
+//		showResendIn(otpErr.RetryAfter)
+//	}
+//
+// Status reports the same information ahead of time, e.g. to disable a
+// resend button until the cooldown has passed.
+package otp
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrResendCooldown is returned for a resend before the cooldown has passed
+	ErrResendCooldown = errors.New("otp resend cooldown has not passed")
+	// ErrMaxResends is returned once a transaction has used all its resends
+	ErrMaxResends = errors.New("otp resend limit reached")
+	// ErrMaxVerifyAttempts is returned once the OTP has been entered wrongly
+	// too many times
+	ErrMaxVerifyAttempts = errors.New("otp verify attempt limit reached")
+	// ErrExpired is returned for verifying an OTP after it expired
+	ErrExpired = errors.New("otp expired")
+)
+
+// Error is returned for an OTP call the tracker rejects. It matches, using
+// errors.Is, one of ErrResendCooldown, ErrMaxResends, ErrMaxVerifyAttempts
+// or ErrExpired.
+type Error struct {
+	Err   error
+	TxnID string
+	// ResendsLeft is the number of new OTPs that may still be requested; at 0
+	// the flow must be started again
+	ResendsLeft int
+	// RetryAfter is the time until a new OTP may be requested, 0 if now
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	if e.ResendsLeft > 0 && e.RetryAfter > 0 {
+		// Rounded up, so that a retry after the reported time is allowed
+		wait := (e.RetryAfter + time.Second - 1).Truncate(time.Second)
+		return fmt.Sprintf("%s for transaction %s, retry after %s", e.Err, e.TxnID, wait)
+	}
+	return fmt.Sprintf("%s for transaction %s", e.Err, e.TxnID)
+}
+
+// Unwrap returns the sentinel error
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Policy sets the OTP limits enforced by a Tracker. A zero field disables
+// its limit, so the zero Policy tracks OTPs without restricting them.
+type Policy struct {
+	ResendCooldown    time.Duration // Minimum time between two OTPs of a transaction
+	MaxResends        int           // Resends allowed per transaction
+	MaxVerifyAttempts int           // Wrong entries allowed per OTP
+	Expiry            time.Duration // Validity of an OTP from the time it was sent
+}
+
+// unlimited is reported as the resends or attempts left when the policy sets
+// no limit
+const unlimited = math.MaxInt
+
+// left returns the uses of a limit that remain after used, or unlimited if
+// limit is 0
+func left(limit, used int) int {
+	if limit == 0 {
+		return unlimited
+	}
+	return max(limit-used, 0)
+}
+
+// DefaultPolicy returns limits modelled on the ABDM gateway: a 60 second
+// resend cooldown, 2 resends, 3 verify attempts per OTP and a 10 minute
+// expiry. Adjust them if the gateway's limits change.
+func DefaultPolicy() Policy {
+	return Policy{
+		ResendCooldown:    60 * time.Second,
+		MaxResends:        2,
+		MaxVerifyAttempts: 3,
+		Expiry:            10 * time.Minute,
+	}
+}
+
+// staleAfter is the time after the last OTP of a transaction when it is
+// forgotten; ABDM transactions are short-lived
+const staleAfter = 24 * time.Hour
+
+// Status is the OTP state of a transaction
+type Status struct {
+	TxnID          string
+	SentAt         time.Time // When the current OTP was sent
+	ExpiresAt      time.Time // When the current OTP expires, zero if it does not
+	Resends        int
+	ResendsLeft    int // math.MaxInt if the policy sets no limit
+	VerifyAttempts int // Wrong entries of the current OTP
+	AttemptsLeft   int // math.MaxInt if the policy sets no limit
+	// ResendAfter is the time until a resend is allowed, 0 if it is allowed
+	// now; it is meaningless when ResendsLeft is 0
+	ResendAfter time.Duration
+}
+
+// CanResend reports whether a resend is allowed now
+func (s Status) CanResend() bool {
+	return s.ResendsLeft > 0 && s.ResendAfter == 0
+}
+
+// transaction is the tracked state of a transaction
+type transaction struct {
+	sentAt   time.Time
+	resends  int
+	attempts int
+}
+
+// Tracker tracks OTPs by transaction ID. It is safe for concurrent use.
+// Transactions it has not seen, e.g. after a restart, are not restricted.
+type Tracker struct {
+	policy Policy
+	now    func() time.Time
+
+	mu   sync.Mutex
+	txns map[string]*transaction
+}
+
+// NewTracker creates a tracker enforcing policy
+func NewTracker(policy Policy) *Tracker {
+	return &Tracker{
+		policy: policy,
+		now:    time.Now,
+		txns:   make(map[string]*transaction),
+	}
+}
+
+// Sent records that a new OTP was sent for the transaction, e.g. by an init
+// call or when the Aadhaar flow moves on to mobile verification. It resets
+// the resend and attempt counts.
+func (t *Tracker) Sent(txnID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	for id, txn := range t.txns {
+		if now.Sub(txn.sentAt) > staleAfter {
+			delete(t.txns, id)
+		}
+	}
+	t.txns[txnID] = &transaction{sentAt: now}
+}
+
+// CheckResend returns an *Error if a resend is not allowed now
+func (t *Tracker) CheckResend(txnID string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	txn, ok := t.txns[txnID]
+	if !ok {
+		return nil
+	}
+	if left(t.policy.MaxResends, txn.resends) == 0 {
+		return t.error(ErrMaxResends, txnID, txn)
+	}
+	if t.resendAfter(txn) > 0 {
+		return t.error(ErrResendCooldown, txnID, txn)
+	}
+	return nil
+}
+
+// Resent records a resend. The new OTP restarts the expiry and attempt count.
+func (t *Tracker) Resent(txnID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	txn, ok := t.txns[txnID]
+	if !ok {
+		txn = &transaction{}
+		t.txns[txnID] = txn
+	}
+	txn.sentAt = t.now()
+	txn.resends++
+	txn.attempts = 0
+}
+
+// CheckVerify returns an *Error if the OTP has expired or has been entered
+// wrongly too many times
+func (t *Tracker) CheckVerify(txnID string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	txn, ok := t.txns[txnID]
+	if !ok {
+		return nil
+	}
+	switch {
+	case left(t.policy.MaxVerifyAttempts, txn.attempts) == 0:
+		return t.error(ErrMaxVerifyAttempts, txnID, txn)
+	case t.policy.Expiry > 0 && !t.now().Before(txn.sentAt.Add(t.policy.Expiry)):
+		return t.error(ErrExpired, txnID, txn)
+	}
+	return nil
+}
+
+// error returns an *Error telling when the user may request a new OTP
+func (t *Tracker) error(err error, txnID string, txn *transaction) *Error {
+	e := &Error{
+		Err:         err,
+		TxnID:       txnID,
+		ResendsLeft: left(t.policy.MaxResends, txn.resends),
+	}
+	if e.ResendsLeft > 0 {
+		e.RetryAfter = t.resendAfter(txn)
+	}
+	return e
+}
+
+// VerifyFailed records a wrong OTP entry
+func (t *Tracker) VerifyFailed(txnID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if txn, ok := t.txns[txnID]; ok {
+		txn.attempts++
+	}
+}
+
+// Verified records a successful verification and forgets the transaction
+func (t *Tracker) Verified(txnID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.txns, txnID)
+}
+
+// Status returns the OTP state of a transaction; ok is false if the
+// transaction is not tracked
+func (t *Tracker) Status(txnID string) (status Status, ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	txn, ok := t.txns[txnID]
+	if !ok {
+		return Status{}, false
+	}
+	status = Status{
+		TxnID:          txnID,
+		SentAt:         txn.sentAt,
+		Resends:        txn.resends,
+		ResendsLeft:    left(t.policy.MaxResends, txn.resends),
+		VerifyAttempts: txn.attempts,
+		AttemptsLeft:   left(t.policy.MaxVerifyAttempts, txn.attempts),
+		ResendAfter:    t.resendAfter(txn),
+	}
+	if t.policy.Expiry > 0 {
+		status.ExpiresAt = txn.sentAt.Add(t.policy.Expiry)
+	}
+	return status, true
+}
+
+// resendAfter returns the remaining resend cooldown of txn
+func (t *Tracker) resendAfter(txn *transaction) time.Duration {
+	return max(txn.sentAt.Add(t.policy.ResendCooldown).Sub(t.now()), 0)
+}
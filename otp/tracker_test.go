@@ -0,0 +1,88 @@
+package otp
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestZeroPolicyIsUnlimited(t *testing.T) {
+	tracker := NewTracker(Policy{})
+	tracker.Sent("txn")
+	for i := 0; i < 5; i++ {
+		if err := tracker.CheckResend("txn"); err != nil {
+			t.Fatalf("resend %d: %v", i, err)
+		}
+		tracker.Resent("txn")
+		tracker.VerifyFailed("txn")
+		if err := tracker.CheckVerify("txn"); err != nil {
+			t.Fatalf("verify %d: %v", i, err)
+		}
+	}
+
+	status, ok := tracker.Status("txn")
+	if !ok || !status.CanResend() || status.AttemptsLeft == 0 || !status.ExpiresAt.IsZero() {
+		t.Errorf("Status = %+v, %v; want no limits", status, ok)
+	}
+}
+
+func TestPolicyLimits(t *testing.T) {
+	now := time.Unix(0, 0)
+	tracker := NewTracker(Policy{ResendCooldown: time.Minute, MaxResends: 1, MaxVerifyAttempts: 1})
+	tracker.now = func() time.Time { return now }
+	tracker.Sent("txn")
+
+	if err := tracker.CheckResend("txn"); !errors.Is(err, ErrResendCooldown) {
+		t.Fatalf("resend during cooldown: err = %v", err)
+	}
+	now = now.Add(time.Minute)
+	tracker.Resent("txn")
+	if err := tracker.CheckResend("txn"); !errors.Is(err, ErrMaxResends) {
+		t.Fatalf("resend over the limit: err = %v", err)
+	}
+
+	tracker.VerifyFailed("txn")
+	if err := tracker.CheckVerify("txn"); !errors.Is(err, ErrMaxVerifyAttempts) {
+		t.Fatalf("verify over the limit: err = %v", err)
+	}
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestMiddlewareCountsOnlyWrongOTPs(t *testing.T) {
+	for _, tt := range []struct {
+		status int
+		wrong  bool
+	}{
+		{status: http.StatusBadRequest, wrong: true},
+		{status: http.StatusUnprocessableEntity, wrong: true},
+		{status: http.StatusUnauthorized},
+		{status: http.StatusForbidden},
+		{status: http.StatusTooManyRequests},
+		{status: http.StatusInternalServerError},
+	} {
+		tracker := NewTracker(DefaultPolicy())
+		tracker.Sent("txn")
+		rt := tracker.Middleware()(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: tt.status, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+		}))
+
+		req, _ := http.NewRequest(http.MethodPost, "https://api.eka.care/abdm/v1/registration/aadhaar/verify", strings.NewReader(`{"txn_id":"txn"}`))
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("%d: %v", tt.status, err)
+		}
+		resp.Body.Close()
+
+		status, _ := tracker.Status("txn")
+		if got := status.VerifyAttempts == 1; got != tt.wrong {
+			t.Errorf("%d counted as a wrong OTP = %v, want %v", tt.status, got, tt.wrong)
+		}
+	}
+}
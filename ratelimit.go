@@ -0,0 +1,45 @@
+package ekasdk
+
+import (
+	"github.com/eka-care/eka-sdk-go/internal/errors"
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// EndpointGroup groups API endpoints that share rate limits and failure behaviour
+type EndpointGroup = interfaces.EndpointGroup
+
+// Endpoint groups used for rate limiting
+const (
+	EndpointGroupAuth              = interfaces.EndpointGroupAuth
+	EndpointGroupOTP               = interfaces.EndpointGroupOTP
+	EndpointGroupProfileRead       = interfaces.EndpointGroupProfileRead
+	EndpointGroupRegistrationWrite = interfaces.EndpointGroupRegistrationWrite
+	EndpointGroupDefault           = interfaces.EndpointGroupDefault
+)
+
+// RateLimit configures the client-side token bucket of an endpoint group
+type RateLimit = interfaces.RateLimit
+
+// ErrRateLimited is returned when waiting for the client-side rate limiter
+// would exceed the request's context deadline
+var ErrRateLimited = errors.ErrRateLimited
+
+// WithRateLimit limits calls to an endpoint group to rate requests per second
+// with bursts of up to burst requests. Calls wait for a token, failing with
+// ErrRateLimited if the wait would exceed their context deadline.
+//
+// Once any limit is configured, every group also slows down automatically
+// when the server answers 429, honouring Retry-After and X-RateLimit-Reset.
+//
+//	client := ekasdk.New(
+//		ekasdk.WithRateLimit(ekasdk.EndpointGroupOTP, 0.5, 2),
+//		ekasdk.WithRateLimit(ekasdk.EndpointGroupRegistrationWrite, 2, 5),
+//	)
+func WithRateLimit(group EndpointGroup, rate float64, burst int) Option {
+	return func(opts *ClientOptions) {
+		if opts.RateLimits == nil {
+			opts.RateLimits = make(map[EndpointGroup]RateLimit)
+		}
+		opts.RateLimits[group] = RateLimit{Rate: rate, Burst: burst}
+	}
+}
@@ -0,0 +1,358 @@
+// Package recorder records HTTP interactions with the Eka APIs to cassette
+// files and replays them, so that tests exercise real gateway responses
+// without network access.
+//
+// Record once against the sandbox, then commit the cassette and replay it in
+// CI:
+//
+//	mode := recorder.ModeReplay
+//	if os.Getenv("EKA_RECORD") != "" {
+//		mode = recorder.ModeRecord
+//	}
+//	rec, err := recorder.New("testdata/cassettes/abha_login.json", mode)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer rec.Close()
+//	client := ekasdk.New(ekasdk.WithMiddleware(rec.Middleware()))
+//
+// Aadhaar and mobile numbers, OTPs, tokens, credentials and patient names
+// are scrubbed before anything is written; add redactors for other fields
+// with WithRedactors. Binary bodies, such as ABHA card images, are replaced
+// by a placeholder unless WithBinaryBodies is given. In replay mode a request that matches no recorded
+// interaction fails with ErrNoMatch rather than reaching the network.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// ErrNoMatch is matched, using errors.Is, by the error returned in replay
+// mode for a request that matches no unused recorded interaction
+var ErrNoMatch = errors.New("no recorded interaction matches request")
+
+// Mode selects whether a Recorder records or replays
+type Mode int
+
+const (
+	// ModeRecord sends requests to the network and records them
+	ModeRecord Mode = iota
+	// ModeReplay answers requests from the cassette without network access
+	ModeReplay
+)
+
+// String returns the name of the mode
+func (m Mode) String() string {
+	switch m {
+	case ModeRecord:
+		return "record"
+	case ModeReplay:
+		return "replay"
+	default:
+		return fmt.Sprintf("Mode(%d)", int(m))
+	}
+}
+
+// Option configures a Recorder
+type Option func(*options)
+
+type options struct {
+	redactors    []Redactor
+	binaryBodies bool
+}
+
+// WithRedactors adds redactors, applied after DefaultRedactor
+func WithRedactors(redactors ...Redactor) Option {
+	return func(o *options) {
+		o.redactors = append(o.redactors, redactors...)
+	}
+}
+
+// WithBinaryBodies keeps binary bodies in the cassette. By default they are
+// replaced by a placeholder with their size and digest, since binary
+// responses such as the ABHA card image show the patient's name, date of
+// birth, ABHA number and photo, which the redactors cannot mask.
+func WithBinaryBodies() Option {
+	return func(o *options) {
+		o.binaryBodies = true
+	}
+}
+
+// Recorder is a middleware that records or replays the interactions of a
+// cassette. It is safe for concurrent use.
+type Recorder struct {
+	path         string
+	mode         Mode
+	redactors    []Redactor
+	binaryBodies bool
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// New creates a recorder for the cassette at path. In replay mode the
+// cassette must exist; in record mode it is written by Close, replacing any
+// earlier recording.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	o := &options{redactors: []Redactor{DefaultRedactor()}}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	r := &Recorder{
+		path:         path,
+		mode:         mode,
+		redactors:    o.redactors,
+		binaryBodies: o.binaryBodies,
+		cassette:     &Cassette{Version: cassetteVersion},
+	}
+
+	switch mode {
+	case ModeRecord:
+	case ModeReplay:
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = cassette
+		r.used = make([]bool, len(cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("unknown recorder mode %s", mode)
+	}
+	return r, nil
+}
+
+// Mode returns the mode of the recorder
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Middleware returns the middleware to install with ekasdk.WithMiddleware
+func (r *Recorder) Middleware() interfaces.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &recorderTransport{next: next, recorder: r}
+	}
+}
+
+// Close writes the cassette in record mode. In replay mode it does nothing.
+func (r *Recorder) Close() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette.Save(r.path)
+}
+
+// Unused returns the recorded interactions that have not been replayed, so
+// that tests can check that every expected call was made
+func (r *Recorder) Unused() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []*Interaction
+	for i, used := range r.used {
+		if !used {
+			unused = append(unused, r.cassette.Interactions[i])
+		}
+	}
+	return unused
+}
+
+// recorderTransport records or replays every request
+type recorderTransport struct {
+	next     http.RoundTripper
+	recorder *Recorder
+}
+
+func (t *recorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.recorder.mode == ModeReplay {
+		return t.recorder.replay(req)
+	}
+	return t.recorder.record(req, t.next)
+}
+
+// record sends req and appends the scrubbed interaction to the cassette
+func (r *Recorder) record(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		// Failed attempts are not recorded; replaying them is not deterministic
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	// Redaction changes the body length, so the recorded length is dropped
+	header := resp.Header.Clone()
+	header.Del("Content-Length")
+	interaction := &Interaction{
+		Request: recordRequest(req, reqBody),
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    header,
+			Body:       respBody,
+		},
+	}
+	r.redact(interaction)
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// replay answers req with the first unused recorded interaction matching it
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	live := &Interaction{Request: recordRequest(req, reqBody)}
+	r.redact(live)
+	key := matchKey(&live.Request)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || matchKey(&interaction.Request) != key {
+			continue
+		}
+		r.used[i] = true
+		return replayResponse(req, &interaction.Response), nil
+	}
+	return nil, fmt.Errorf("%w: %s %s in cassette %s", ErrNoMatch, req.Method, requestTarget(&live.Request), r.path)
+}
+
+// redact applies the redactors to an interaction and replaces its binary
+// bodies unless WithBinaryBodies was given
+func (r *Recorder) redact(i *Interaction) {
+	for _, redactor := range r.redactors {
+		redactor(i)
+	}
+	if !r.binaryBodies {
+		i.Request.Body = redactBinary(i.Request.Body)
+		i.Response.Body = redactBinary(i.Response.Body)
+	}
+}
+
+// readRequestBody returns the request body without consuming it
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// recordRequest returns the recorded form of req
+func recordRequest(req *http.Request, body []byte) Request {
+	return Request{
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Query:   req.URL.RawQuery,
+		Headers: req.Header.Clone(),
+		Body:    body,
+	}
+}
+
+// matchKey identifies a request by method, path, query and normalised body.
+// Headers are not matched, since they carry request IDs and tokens that
+// change between runs.
+func matchKey(req *Request) string {
+	return strings.Join([]string{
+		req.Method,
+		req.Path,
+		normaliseQuery(req.Query),
+		string(normaliseBody(req.Body)),
+	}, "\n")
+}
+
+// normaliseQuery sorts the query parameters
+func normaliseQuery(query string) string {
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return query
+	}
+	return values.Encode()
+}
+
+// normaliseBody re-encodes JSON bodies with sorted keys and no insignificant
+// whitespace; other bodies are compared as they are
+func normaliseBody(body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return body
+	}
+	normalised, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return normalised
+}
+
+// requestTarget returns the path and query of a recorded request
+func requestTarget(req *Request) string {
+	if req.Query == "" {
+		return req.Path
+	}
+	return req.Path + "?" + req.Query
+}
+
+// replayResponse builds the response to req from a recorded response
+func replayResponse(req *http.Request, recorded *Response) *http.Response {
+	header := recorded.Headers.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
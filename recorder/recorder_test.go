@@ -0,0 +1,193 @@
+package recorder
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// pngCard stands in for an ABHA card image
+var pngCard = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\xff\xfe")
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// gateway answers every request with status 200, contentType and body
+func gateway(contentType string, body []byte) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {contentType}, "Set-Cookie": {"session=abc"}},
+			Body:       io.NopCloser(bytes.NewReader(body)),
+			Request:    req,
+		}, nil
+	})
+}
+
+// noNetwork fails the test if a replayed request reaches the network
+func noNetwork(t *testing.T) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		t.Errorf("replay sent %s %s to the network", req.Method, req.URL.Path)
+		return nil, errors.New("network access in replay mode")
+	})
+}
+
+func newRequest(t *testing.T, method, target, body string) *http.Request {
+	t.Helper()
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, "https://api.eka.care"+target, reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer live-token")
+	return req
+}
+
+// record records one request against backend and returns the cassette path
+// and its contents
+func record(t *testing.T, req *http.Request, backend http.RoundTripper, opts ...Option) (string, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := New(path, ModeRecord, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rec.Middleware()(backend).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("record: %v", err)
+	}
+	resp.Body.Close()
+	if err := rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path, string(data)
+}
+
+func TestRecordRedactsSecrets(t *testing.T) {
+	req := newRequest(t, http.MethodPost, "/abdm/na/v1/registration/aadhaar/verify?mobile=9876543210",
+		`{"txn_id":"t-1","otp":"123456","aadhaar_number":"123412341234","mobile":"9876543210"}`)
+	resp := `{"token":"secret-token","refresh_token":"secret-refresh","profile":{"first_name":"Asha","last_name":"Rao","abha_address":"asha@abdm"}}`
+	_, cassette := record(t, req, gateway("application/json", []byte(resp)),
+		WithRedactors(RedactFields("abha_address"), RedactHeaders("Content-Type")))
+
+	for _, secret := range []string{"123456", "123412341234", "9876543210", "live-token", "secret-token", "secret-refresh", "Asha", "Rao", "asha@abdm", "session=abc", "application/json"} {
+		if strings.Contains(cassette, secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, cassette)
+		}
+	}
+	if !strings.Contains(cassette, "t-1") || !strings.Contains(cassette, "1234") {
+		t.Errorf("cassette lost non-sensitive data:\n%s", cassette)
+	}
+}
+
+func TestBinaryBodiesAreRedactedByDefault(t *testing.T) {
+	req := newRequest(t, http.MethodGet, "/abdm/v1/profile/asset/card", "")
+	path, cassette := record(t, req, gateway("image/png", pngCard))
+	if strings.Contains(cassette, "base64") || !strings.Contains(cassette, "[binary body redacted: 18 bytes, sha256 ") {
+		t.Errorf("cassette keeps the card image:\n%s", cassette)
+	}
+	// The live response is not altered
+	c, err := LoadCassette(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(c.Interactions[0].Response.Body, pngCard) {
+		t.Error("recorded body is the card image")
+	}
+
+	req = newRequest(t, http.MethodGet, "/abdm/v1/profile/asset/card", "")
+	path, _ = record(t, req, gateway("image/png", pngCard), WithBinaryBodies())
+	rec, err := New(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rec.Middleware()(noNetwork(t)).RoundTrip(newRequest(t, http.MethodGet, "/abdm/v1/profile/asset/card", ""))
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !bytes.Equal(body, pngCard) {
+		t.Errorf("replayed body = %q, want the kept card image", body)
+	}
+}
+
+func TestReplayMatchesNormalisedRequests(t *testing.T) {
+	req := newRequest(t, http.MethodPost, "/abdm/na/v1/registration/aadhaar/verify?b=2&a=1", `{"txn_id":"t-1","otp":"123456"}`)
+	path, _ := record(t, req, gateway("application/json", []byte(`{"skip_state":"abha_end"}`)))
+
+	rec, err := New(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := rec.Middleware()(noNetwork(t))
+
+	// Key order, whitespace, query order and the OTP value do not matter
+	live := newRequest(t, http.MethodPost, "/abdm/na/v1/registration/aadhaar/verify?a=1&b=2", "{\n  \"otp\": \"654321\",\n  \"txn_id\": \"t-1\"\n}")
+	resp, err := rt.RoundTrip(live)
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != `{"skip_state":"abha_end"}` {
+		t.Errorf("replayed body = %q", body)
+	}
+	if len(rec.Unused()) != 0 {
+		t.Errorf("Unused = %d interactions, want 0", len(rec.Unused()))
+	}
+}
+
+func TestReplayFailsOnUnmatchedRequests(t *testing.T) {
+	req := newRequest(t, http.MethodPost, "/abdm/na/v1/registration/aadhaar/verify", `{"txn_id":"t-1"}`)
+	path, _ := record(t, req, gateway("application/json", []byte(`{}`)))
+
+	rec, err := New(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := rec.Middleware()(noNetwork(t))
+
+	for _, tt := range []struct{ method, target, body string }{
+		{http.MethodPost, "/abdm/na/v1/registration/aadhaar/verify", `{"txn_id":"t-2"}`},
+		{http.MethodGet, "/abdm/na/v1/registration/aadhaar/verify", ""},
+		{http.MethodPost, "/abdm/na/v1/registration/mobile/verify", `{"txn_id":"t-1"}`},
+	} {
+		_, err := rt.RoundTrip(newRequest(t, tt.method, tt.target, tt.body))
+		if !errors.Is(err, ErrNoMatch) {
+			t.Errorf("%s %s %s: err = %v, want ErrNoMatch", tt.method, tt.target, tt.body, err)
+		}
+	}
+	if len(rec.Unused()) != 1 {
+		t.Errorf("Unused = %d interactions, want 1", len(rec.Unused()))
+	}
+
+	// Each recording is replayed once
+	for i, want := range []error{nil, ErrNoMatch} {
+		resp, err := rt.RoundTrip(newRequest(t, http.MethodPost, "/abdm/na/v1/registration/aadhaar/verify", `{"txn_id":"t-1"}`))
+		if !errors.Is(err, want) {
+			t.Errorf("replay %d: err = %v, want %v", i+1, err, want)
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+	}
+
+	if _, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay); err == nil {
+		t.Error("replaying a missing cassette succeeded")
+	}
+}
@@ -0,0 +1,118 @@
+package recorder
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"unicode/utf8"
+
+	"github.com/eka-care/eka-sdk-go/internal/redact"
+)
+
+// Redactor scrubs an interaction before it is written to a cassette. In
+// replay mode the same redactors are applied to live requests before they are
+// matched, so a request carrying a real OTP matches its scrubbed recording.
+type Redactor func(i *Interaction)
+
+// NameFields are the JSON fields holding patient names, masked by DefaultRedactor
+var NameFields = []string{"name", "first_name", "middle_name", "last_name", "full_name", "patient_name"}
+
+// DefaultRedactor masks Aadhaar and mobile numbers, OTPs, tokens, client
+// secrets and patient names in bodies and query strings, and credentials and
+// cookies in headers
+func DefaultRedactor() Redactor {
+	extra := make(map[string]redact.Rule, len(NameFields))
+	for _, name := range NameFields {
+		extra[name] = redact.RuleFull
+	}
+	return fieldRedactor(redact.New(extra))
+}
+
+// RedactFields masks the values of the given JSON body fields and query
+// parameters entirely. Field names are matched case-insensitively.
+func RedactFields(fields ...string) Redactor {
+	extra := make(map[string]redact.Rule, len(fields))
+	for _, name := range fields {
+		extra[name] = redact.RuleFull
+	}
+	return fieldRedactor(redact.New(extra))
+}
+
+// RedactHeaders masks the values of the given request and response headers
+func RedactHeaders(names ...string) Redactor {
+	return func(i *Interaction) {
+		for _, name := range names {
+			maskHeader(i.Request.Headers, name)
+			maskHeader(i.Response.Headers, name)
+		}
+	}
+}
+
+// fieldRedactor applies r to bodies, query parameters and headers
+func fieldRedactor(r *redact.Redactor) Redactor {
+	return func(i *Interaction) {
+		i.Request.Query = redactQuery(r, i.Request.Query)
+		i.Request.Headers = redactHeader(i.Request.Headers)
+		i.Request.Body = redactBody(r, i.Request.Body)
+		i.Response.Headers = redactHeader(i.Response.Headers)
+		i.Response.Body = redactBody(r, i.Response.Body)
+	}
+}
+
+// redactBody masks a JSON or text body; binary bodies are left to
+// redactBinary
+func redactBody(r *redact.Redactor, body Body) Body {
+	if len(body) == 0 || !utf8.Valid(body) {
+		return body
+	}
+	return r.JSON(body)
+}
+
+// redactBinary replaces a binary body, such as an ABHA card image, with a
+// placeholder naming its size and SHA-256 digest. The digest keeps binary
+// request bodies matchable in replay mode.
+func redactBinary(body Body) Body {
+	if len(body) == 0 || utf8.Valid(body) {
+		return body
+	}
+	sum := sha256.Sum256(body)
+	return Body(fmt.Sprintf("[binary body redacted: %d bytes, sha256 %s]", len(body), hex.EncodeToString(sum[:])))
+}
+
+// redactQuery masks the values of a raw query string
+func redactQuery(r *redact.Redactor, query string) string {
+	if query == "" {
+		return ""
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return r.String(query)
+	}
+	for key, vals := range values {
+		for j, v := range vals {
+			vals[j] = r.Field(key, v)
+		}
+	}
+	return values.Encode()
+}
+
+// redactHeader masks credentials, cookies and Aadhaar and mobile numbers in h
+func redactHeader(h http.Header) http.Header {
+	if h == nil {
+		return nil
+	}
+	return redact.Header(h)
+}
+
+// maskHeader masks every value of a header
+func maskHeader(h http.Header, name string) {
+	if h == nil {
+		return
+	}
+	key := http.CanonicalHeaderKey(name)
+	for j := range h[key] {
+		h[key][j] = redact.Masked
+	}
+}
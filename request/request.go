@@ -0,0 +1,80 @@
+// Package request provides per-call options accepted by every service method.
+//
+// Options are passed as trailing arguments and only affect that single call:
+//
+//	resp, err := client.ABDM.Login().LoginInit(ctx, headers, req,
+//		request.WithTimeout(5*time.Second),
+//		request.WithRequestID(traceID),
+//		request.WithHeader("X-Clinic-Id", clinicID),
+//	)
+package request
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+)
+
+// Option configures a single API call
+type Option = interfaces.RequestOption
+
+// Options holds the per-call settings that an Option modifies
+type Options = interfaces.RequestOptions
+
+// ResponseMetadata describes the raw HTTP exchange behind a service call
+type ResponseMetadata = interfaces.ResponseMetadata
+
+// WithHeader sets an extra header on the request, overriding any header the
+// SDK would otherwise send with the same name
+func WithHeader(key, value string) Option {
+	return func(o *Options) {
+		if o.Header == nil {
+			o.Header = make(http.Header)
+		}
+		o.Header.Set(key, value)
+	}
+}
+
+// WithTimeout sets a deadline for the call. The shorter of this timeout and
+// any deadline already on the context wins.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.Timeout = timeout
+	}
+}
+
+// WithRequestID sets the X-Request-Id header used to correlate the call with Eka support
+func WithRequestID(requestID string) Option {
+	return func(o *Options) {
+		o.RequestID = requestID
+	}
+}
+
+// WithIdempotencyKey sets the Idempotency-Key header so that retried writes
+// are not applied twice
+func WithIdempotencyKey(key string) Option {
+	return func(o *Options) {
+		o.IdempotencyKey = key
+	}
+}
+
+// WithBaseURL sends the call to a different base URL, e.g. a regional gateway or a mock server
+func WithBaseURL(baseURL string) Option {
+	return func(o *Options) {
+		o.BaseURL = baseURL
+	}
+}
+
+// WithResponseMetadata fills md with the status, headers, request ID, latency,
+// attempt count and raw body of the call. It is filled for failed calls too
+// whenever a response was received, which is what Eka support asks for.
+//
+//	var md request.ResponseMetadata
+//	resp, err := client.ABDM.Profile().GetProfile(ctx, headers, request.WithResponseMetadata(&md))
+//	log.Printf("request %s took %s", md.RequestID, md.Latency)
+func WithResponseMetadata(md *ResponseMetadata) Option {
+	return func(o *Options) {
+		o.Metadata = md
+	}
+}
@@ -0,0 +1,204 @@
+package abha
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Date is a calendar date that may be partial: ABHA profiles often carry only
+// a year of birth, or a year and month. Unknown parts are zero.
+type Date struct {
+	Year  int
+	Month int // 1-12, or 0 if unknown
+	Day   int // 1-31, or 0 if unknown
+}
+
+// NewDate returns the date for the given parts, which must form a valid
+// date; month and day may be 0 if unknown, but a day requires a month
+func NewDate(year, month, day int) (Date, error) {
+	d := Date{Year: year, Month: month, Day: day}
+	if err := d.validate(); err != nil {
+		return Date{}, err
+	}
+	return d, nil
+}
+
+// DateFromParts returns the date for the optional day, month and year fields
+// of an API response. Parts that do not form a valid date are dropped, from
+// the day up, so that a bad day still keeps the year and month.
+func DateFromParts(year, month, day *int) Date {
+	var d Date
+	if year == nil || *year <= 0 {
+		return d
+	}
+	d.Year = *year
+	if month == nil || d.with(*month, 0).validate() != nil {
+		return d
+	}
+	d.Month = *month
+	if day == nil || d.with(d.Month, *day).validate() != nil {
+		return d
+	}
+	d.Day = *day
+	return d
+}
+
+// ParseDate parses a full or partial date in one of the forms
+// YYYY-MM-DD, DD-MM-YYYY, DD/MM/YYYY, YYYY-MM or YYYY. A month or day
+// written as 00 is an error; partial dates leave the part out instead.
+func ParseDate(s string) (Date, error) {
+	s = strings.TrimSpace(s)
+	parts := strings.FieldsFunc(s, func(r rune) bool { return r == '-' || r == '/' })
+	nums := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return Date{}, fmt.Errorf("invalid date %q", s)
+		}
+		nums[i] = n
+	}
+
+	var d Date
+	switch {
+	case len(nums) == 1 && len(parts[0]) == 4:
+		d = Date{Year: nums[0]}
+	case len(nums) == 2 && len(parts[0]) == 4:
+		d = Date{Year: nums[0], Month: nums[1]}
+	case len(nums) == 3 && len(parts[0]) == 4:
+		d = Date{Year: nums[0], Month: nums[1], Day: nums[2]}
+	case len(nums) == 3 && len(parts[2]) == 4:
+		d = Date{Year: nums[2], Month: nums[1], Day: nums[0]}
+	default:
+		return Date{}, fmt.Errorf("invalid date %q", s)
+	}
+	if len(nums) >= 2 && d.Month == 0 || len(nums) == 3 && d.Day == 0 {
+		return Date{}, fmt.Errorf("invalid date %q: month and day must not be 0", s)
+	}
+	if err := d.validate(); err != nil {
+		return Date{}, fmt.Errorf("invalid date %q: %w", s, err)
+	}
+	return d, nil
+}
+
+// with returns d with the given month and day
+func (d Date) with(month, day int) Date {
+	d.Month = month
+	d.Day = day
+	return d
+}
+
+// validate checks that the known parts form a valid date
+func (d Date) validate() error {
+	switch {
+	case d.Year <= 0:
+		return fmt.Errorf("year must be positive, got %d", d.Year)
+	case d.Month < 0 || d.Month > 12:
+		return fmt.Errorf("month must be between 1 and 12, got %d", d.Month)
+	case d.Day != 0 && d.Month == 0:
+		return fmt.Errorf("day given without a month")
+	case d.Day < 0 || d.Day > daysIn(d.Year, d.Month):
+		return fmt.Errorf("day must be between 1 and %d, got %d", daysIn(d.Year, d.Month), d.Day)
+	}
+	return nil
+}
+
+// daysIn returns the number of days in a month
+func daysIn(year, month int) int {
+	if month == 0 {
+		return 31
+	}
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// IsZero reports whether the date is unknown
+func (d Date) IsZero() bool {
+	return d.Year == 0
+}
+
+// IsPartial reports whether the month or day is unknown
+func (d Date) IsPartial() bool {
+	return !d.IsZero() && (d.Month == 0 || d.Day == 0)
+}
+
+// String formats the date as YYYY-MM-DD, YYYY-MM or YYYY, or returns "" for
+// an unknown date
+func (d Date) String() string {
+	switch {
+	case d.IsZero():
+		return ""
+	case d.Month == 0:
+		return fmt.Sprintf("%04d", d.Year)
+	case d.Day == 0:
+		return fmt.Sprintf("%04d-%02d", d.Year, d.Month)
+	default:
+		return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+	}
+}
+
+// Time returns the date at midnight UTC; ok is false for partial or unknown dates
+func (d Date) Time() (t time.Time, ok bool) {
+	if d.IsZero() || d.IsPartial() {
+		return time.Time{}, false
+	}
+	return time.Date(d.Year, time.Month(d.Month), d.Day, 0, 0, 0, 0, time.UTC), true
+}
+
+// MarshalText formats the date like String
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses a date in any form accepted by ParseDate; an empty
+// value is the unknown date
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Date{}
+		return nil
+	}
+	parsed, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// AgeRange returns the youngest and oldest possible age in completed years
+// at the given time. Unknown parts widen the range: a year of birth alone
+// gives ages one year apart until the end of the year. Both are 0 for an
+// unknown date.
+func (d Date) AgeRange(at time.Time) (youngest, oldest int) {
+	if d.IsZero() {
+		return 0, 0
+	}
+	// The latest possible birthday gives the youngest age
+	lastMonth, lastDay := d.Month, d.Day
+	if lastMonth == 0 {
+		lastMonth = 12
+	}
+	if lastDay == 0 {
+		lastDay = daysIn(d.Year, lastMonth)
+	}
+	firstMonth, firstDay := max(d.Month, 1), max(d.Day, 1)
+
+	return ageAt(d.Year, lastMonth, lastDay, at), ageAt(d.Year, firstMonth, firstDay, at)
+}
+
+// Age returns the age in completed years at the given time. For partial
+// dates it is the youngest possible age, and exact is false unless every
+// possible birthday gives the same age.
+func (d Date) Age(at time.Time) (years int, exact bool) {
+	youngest, oldest := d.AgeRange(at)
+	return youngest, !d.IsZero() && youngest == oldest
+}
+
+// ageAt returns the completed years between a birth date and at
+func ageAt(year, month, day int, at time.Time) int {
+	age := at.Year() - year
+	if int(at.Month()) < month || (int(at.Month()) == month && at.Day() < day) {
+		age--
+	}
+	return max(age, 0)
+}
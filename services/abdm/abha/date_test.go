@@ -0,0 +1,123 @@
+package abha
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		want    Date
+		wantErr bool
+	}{
+		{in: "1990-07-15", want: Date{1990, 7, 15}},
+		{in: "15-07-1990", want: Date{1990, 7, 15}},
+		{in: "15/07/1990", want: Date{1990, 7, 15}},
+		{in: " 1990-07 ", want: Date{1990, 7, 0}},
+		{in: "1990", want: Date{Year: 1990}},
+		{in: "2024-02-29", want: Date{2024, 2, 29}},
+		{in: "29-02-2024", want: Date{2024, 2, 29}},
+		{in: "2023-02-29", wantErr: true},
+		{in: "1900-02-29", wantErr: true},
+		{in: "2000-02-29", want: Date{2000, 2, 29}},
+		{in: "2024-00-00", wantErr: true},
+		{in: "2024-00", wantErr: true},
+		{in: "2024-05-00", wantErr: true},
+		{in: "00-05-2024", wantErr: true},
+		{in: "2024-13-01", wantErr: true},
+		{in: "2024-04-31", wantErr: true},
+		{in: "0000", wantErr: true},
+		{in: "01-02-03", wantErr: true},
+		{in: "90", wantErr: true},
+		{in: "1990-07-15T00:00:00Z", wantErr: true},
+		{in: "", wantErr: true},
+	} {
+		got, err := ParseDate(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseDate(%q) = %v, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseDate(%q) = %+v, %v; want %+v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestDateFromParts(t *testing.T) {
+	p := func(n int) *int { return &n }
+	for _, tt := range []struct {
+		name             string
+		year, month, day *int
+		want             Date
+	}{
+		{name: "full", year: p(1990), month: p(7), day: p(15), want: Date{1990, 7, 15}},
+		{name: "year only", year: p(1990), want: Date{Year: 1990}},
+		{name: "no year", month: p(7), day: p(15), want: Date{}},
+		{name: "zero year", year: p(0), month: p(7), want: Date{}},
+		{name: "invalid month drops month and day", year: p(1990), month: p(13), day: p(1), want: Date{Year: 1990}},
+		{name: "invalid day keeps month", year: p(1990), month: p(4), day: p(31), want: Date{1990, 4, 0}},
+		{name: "leap day", year: p(2024), month: p(2), day: p(29), want: Date{2024, 2, 29}},
+		{name: "leap day in a common year", year: p(2023), month: p(2), day: p(29), want: Date{2023, 2, 0}},
+		{name: "zero month", year: p(1990), month: p(0), day: p(5), want: Date{Year: 1990}},
+	} {
+		if got := DateFromParts(tt.year, tt.month, tt.day); got != tt.want {
+			t.Errorf("%s: DateFromParts = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDateAgeRange(t *testing.T) {
+	at := time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		date             Date
+		youngest, oldest int
+		exact            bool
+	}{
+		{date: Date{2000, 6, 15}, youngest: 26, oldest: 26, exact: true},
+		{date: Date{2000, 6, 16}, youngest: 25, oldest: 25, exact: true},
+		{date: Date{Year: 2000}, youngest: 25, oldest: 26},
+		{date: Date{2000, 6, 0}, youngest: 25, oldest: 26},
+		{date: Date{2000, 5, 0}, youngest: 26, oldest: 26, exact: true},
+		{date: Date{Year: 2026}, youngest: 0, oldest: 0, exact: true},
+		{date: Date{}, youngest: 0, oldest: 0},
+	} {
+		youngest, oldest := tt.date.AgeRange(at)
+		if youngest != tt.youngest || oldest != tt.oldest {
+			t.Errorf("%v.AgeRange = %d, %d; want %d, %d", tt.date, youngest, oldest, tt.youngest, tt.oldest)
+		}
+		if age, exact := tt.date.Age(at); age != tt.youngest || exact != tt.exact {
+			t.Errorf("%v.Age = %d, %v; want %d, %v", tt.date, age, exact, tt.youngest, tt.exact)
+		}
+	}
+
+	// A leap day birthday is not reached until March 1 in common years
+	leap := Date{2024, 2, 29}
+	if age, _ := leap.Age(time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)); age != 0 {
+		t.Errorf("age on 2025-02-28 = %d, want 0", age)
+	}
+	if age, _ := leap.Age(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)); age != 1 {
+		t.Errorf("age on 2025-03-01 = %d, want 1", age)
+	}
+}
+
+func TestParseGender(t *testing.T) {
+	for in, want := range map[string]Gender{
+		"M":           GenderMale,
+		" male ":      GenderMale,
+		"f":           GenderFemale,
+		"FEMALE":      GenderFemale,
+		"O":           GenderOther,
+		"others":      GenderOther,
+		"T":           GenderTransgender,
+		"transgender": GenderTransgender,
+		"":            GenderUnknown,
+		"U":           GenderUnknown,
+	} {
+		if got := ParseGender(in); got != want {
+			t.Errorf("ParseGender(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
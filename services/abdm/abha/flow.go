@@ -0,0 +1,183 @@
+package abha
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Action is what the integrator must do next for a SkipState
+type Action string
+
+const (
+	// ActionNone means the flow is complete
+	ActionNone Action = "none"
+	// ActionVerifyMobileOTP means the user must verify an OTP sent to a
+	// mobile number not linked to their Aadhaar
+	ActionVerifyMobileOTP Action = "verify_mobile_otp"
+	// ActionSelectAbhaAddress means the user must pick one of their ABHA
+	// addresses to log in with
+	ActionSelectAbhaAddress Action = "select_abha_address"
+	// ActionCreateAbhaAddress means the user must choose a new ABHA address
+	ActionCreateAbhaAddress Action = "create_abha_address"
+	// ActionUnknown is returned for skip states this SDK version does not know
+	ActionUnknown Action = "unknown"
+)
+
+// NextAction returns the action the skip state asks for, or ActionUnknown if
+// the state is not one of KnownSkipStates
+func (s SkipState) NextAction() Action {
+	switch s {
+	case SkipStateAbhaEnd:
+		return ActionNone
+	case SkipStateConfirmMobileOTP:
+		return ActionVerifyMobileOTP
+	case SkipStateAbhaSelect:
+		return ActionSelectAbhaAddress
+	case SkipStateAbhaCreate:
+		return ActionCreateAbhaAddress
+	default:
+		return ActionUnknown
+	}
+}
+
+// Flow is an ABDM journey made of several SDK calls
+type Flow string
+
+const (
+	// FlowAadhaarRegistration creates an ABHA through Aadhaar OTP verification
+	FlowAadhaarRegistration Flow = "aadhaar_registration"
+	// FlowMobileRegistration creates an ABHA address through mobile OTP verification
+	FlowMobileRegistration Flow = "mobile_registration"
+	// FlowLogin logs in to an existing ABHA
+	FlowLogin Flow = "login"
+)
+
+// Step is an SDK call that returns a skip_state. Steps are named by
+// operation, as reported to hooks, metrics and traces.
+type Step string
+
+const (
+	StepAadhaarVerify       Step = "abdm.registration.AadhaarVerify"
+	StepAadhaarMobileVerify Step = "abdm.registration.AadhaarMobileVerify"
+	StepAadhaarCreatePHR    Step = "abdm.registration.AadhaarCreatePHR"
+	StepMobileVerify        Step = "abdm.registration.MobileVerify"
+	StepMobileCreatePHR     Step = "abdm.registration.MobileCreatePHR"
+	StepLoginVerify         Step = "abdm.login.LoginVerify"
+	StepLoginWithPHRAddress Step = "abdm.login.LoginWithPHRAddress"
+)
+
+// Transition is a skip state a step may return and the step that resolves it
+type Transition struct {
+	State SkipState
+	// Next is the call that resolves State, or "" when the flow is complete
+	Next Step
+}
+
+// transitions lists, per flow and step, the skip states the gateway may
+// return and the call that resolves each of them
+var transitions = map[Flow]map[Step][]Transition{
+	FlowAadhaarRegistration: {
+		StepAadhaarVerify: {
+			{State: SkipStateConfirmMobileOTP, Next: StepAadhaarMobileVerify},
+			{State: SkipStateAbhaCreate, Next: StepAadhaarCreatePHR},
+			{State: SkipStateAbhaSelect, Next: StepLoginWithPHRAddress},
+			{State: SkipStateAbhaEnd},
+		},
+		StepAadhaarMobileVerify: {
+			{State: SkipStateAbhaCreate, Next: StepAadhaarCreatePHR},
+			{State: SkipStateAbhaSelect, Next: StepLoginWithPHRAddress},
+			{State: SkipStateAbhaEnd},
+		},
+		StepAadhaarCreatePHR: {
+			{State: SkipStateAbhaEnd},
+		},
+		StepLoginWithPHRAddress: {
+			{State: SkipStateAbhaEnd},
+		},
+	},
+	FlowMobileRegistration: {
+		StepMobileVerify: {
+			{State: SkipStateAbhaCreate, Next: StepMobileCreatePHR},
+			{State: SkipStateAbhaSelect, Next: StepLoginWithPHRAddress},
+			{State: SkipStateAbhaEnd},
+		},
+		StepMobileCreatePHR: {
+			{State: SkipStateAbhaEnd},
+		},
+		StepLoginWithPHRAddress: {
+			{State: SkipStateAbhaEnd},
+		},
+	},
+	FlowLogin: {
+		StepLoginVerify: {
+			{State: SkipStateAbhaSelect, Next: StepLoginWithPHRAddress},
+			{State: SkipStateAbhaEnd},
+		},
+		StepLoginWithPHRAddress: {
+			{State: SkipStateAbhaEnd},
+		},
+	},
+}
+
+// Transitions returns the skip states step may return in the flow and the
+// call that resolves each of them, or nil if the step is not part of the flow
+func (f Flow) Transitions(step Step) []Transition {
+	return slices.Clone(transitions[f][step])
+}
+
+// ErrInvalidSkipState is matched by the errors returned by Flow.Next for skip
+// states a step cannot return
+var ErrInvalidSkipState = errors.New("invalid skip state")
+
+// InvalidSkipStateError reports a skip state that is not valid after a step
+// of a flow, either because the step does not belong to the flow or because
+// the gateway returned an unexpected or unknown state
+type InvalidSkipStateError struct {
+	Flow    Flow
+	Step    Step
+	State   SkipState
+	Allowed []SkipState // States the step may return, empty if the step is not part of the flow
+}
+
+// Error implements the error interface
+func (e *InvalidSkipStateError) Error() string {
+	if len(e.Allowed) == 0 {
+		return fmt.Sprintf("%s: %s is not a step of the %s flow", ErrInvalidSkipState, e.Step, e.Flow)
+	}
+	allowed := make([]string, len(e.Allowed))
+	for i, state := range e.Allowed {
+		allowed[i] = string(state)
+	}
+	kind := "unexpected"
+	if !e.State.IsKnown() {
+		kind = "unknown"
+	}
+	return fmt.Sprintf("%s: %s skip state %q after %s in the %s flow, expected one of %s",
+		ErrInvalidSkipState, kind, e.State, e.Step, e.Flow, strings.Join(allowed, ", "))
+}
+
+// Is makes errors.Is(err, ErrInvalidSkipState) match
+func (e *InvalidSkipStateError) Is(target error) bool {
+	return target == ErrInvalidSkipState
+}
+
+// Next checks that state may follow step in the flow and returns the call
+// that resolves it, or "" when the flow is complete. It returns an
+// *InvalidSkipStateError for states the table does not allow, including
+// states this SDK version does not know.
+func (f Flow) Next(step Step, state SkipState) (Step, error) {
+	allowed := transitions[f][step]
+	for _, t := range allowed {
+		if t.State == state {
+			return t.Next, nil
+		}
+	}
+
+	err := &InvalidSkipStateError{Flow: f, Step: step, State: state}
+	for _, t := range allowed {
+		err.Allowed = append(err.Allowed, t.State)
+	}
+	return "", err
+}
@@ -0,0 +1,131 @@
+package abha
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestFlowTablesAreReadOnly(t *testing.T) {
+	KnownSkipStates()[0] = "tampered"
+	if !SkipStateAbhaEnd.IsKnown() {
+		t.Error("modifying KnownSkipStates changed IsKnown")
+	}
+
+	got := FlowLogin.Transitions(StepLoginVerify)
+	got[0] = Transition{State: "tampered"}
+	if next, err := FlowLogin.Next(StepLoginVerify, SkipStateAbhaSelect); err != nil || next != StepLoginWithPHRAddress {
+		t.Errorf("Next = %q, %v after modifying Transitions", next, err)
+	}
+
+	if got := FlowLogin.Transitions(StepMobileVerify); got != nil {
+		t.Errorf("Transitions of a step outside the flow = %v, want nil", got)
+	}
+	_, err := FlowLogin.Next(StepMobileVerify, SkipStateAbhaEnd)
+	var invalid *InvalidSkipStateError
+	if !errors.As(err, &invalid) || len(invalid.Allowed) != 0 {
+		t.Errorf("Next for a step outside the flow: err = %v", err)
+	}
+}
+
+func TestNextAction(t *testing.T) {
+	for state, want := range map[SkipState]Action{
+		SkipStateAbhaEnd:          ActionNone,
+		SkipStateConfirmMobileOTP: ActionVerifyMobileOTP,
+		SkipStateAbhaSelect:       ActionSelectAbhaAddress,
+		SkipStateAbhaCreate:       ActionCreateAbhaAddress,
+		"abha_link_aadhaar":       ActionUnknown,
+		"":                        ActionUnknown,
+	} {
+		if got := state.NextAction(); got != want {
+			t.Errorf("%q.NextAction() = %s, want %s", state, got, want)
+		}
+		if known := want != ActionUnknown; state.IsKnown() != known {
+			t.Errorf("%q.IsKnown() = %v, want %v", state, state.IsKnown(), known)
+		}
+	}
+}
+
+func TestFlowNext(t *testing.T) {
+	for _, tt := range []struct {
+		flow  Flow
+		step  Step
+		state SkipState
+		next  Step
+	}{
+		{FlowAadhaarRegistration, StepAadhaarVerify, SkipStateConfirmMobileOTP, StepAadhaarMobileVerify},
+		{FlowAadhaarRegistration, StepAadhaarVerify, SkipStateAbhaCreate, StepAadhaarCreatePHR},
+		{FlowAadhaarRegistration, StepAadhaarVerify, SkipStateAbhaSelect, StepLoginWithPHRAddress},
+		{FlowAadhaarRegistration, StepAadhaarVerify, SkipStateAbhaEnd, ""},
+		{FlowAadhaarRegistration, StepAadhaarMobileVerify, SkipStateAbhaCreate, StepAadhaarCreatePHR},
+		{FlowAadhaarRegistration, StepAadhaarMobileVerify, SkipStateAbhaSelect, StepLoginWithPHRAddress},
+		{FlowAadhaarRegistration, StepAadhaarMobileVerify, SkipStateAbhaEnd, ""},
+		{FlowAadhaarRegistration, StepAadhaarCreatePHR, SkipStateAbhaEnd, ""},
+		{FlowAadhaarRegistration, StepLoginWithPHRAddress, SkipStateAbhaEnd, ""},
+		{FlowMobileRegistration, StepMobileVerify, SkipStateAbhaCreate, StepMobileCreatePHR},
+		{FlowMobileRegistration, StepMobileVerify, SkipStateAbhaSelect, StepLoginWithPHRAddress},
+		{FlowMobileRegistration, StepMobileVerify, SkipStateAbhaEnd, ""},
+		{FlowMobileRegistration, StepMobileCreatePHR, SkipStateAbhaEnd, ""},
+		{FlowMobileRegistration, StepLoginWithPHRAddress, SkipStateAbhaEnd, ""},
+		{FlowLogin, StepLoginVerify, SkipStateAbhaSelect, StepLoginWithPHRAddress},
+		{FlowLogin, StepLoginVerify, SkipStateAbhaEnd, ""},
+		{FlowLogin, StepLoginWithPHRAddress, SkipStateAbhaEnd, ""},
+	} {
+		next, err := tt.flow.Next(tt.step, tt.state)
+		if err != nil || next != tt.next {
+			t.Errorf("%s: Next(%s, %s) = %q, %v; want %q", tt.flow, tt.step, tt.state, next, err, tt.next)
+		}
+	}
+}
+
+func TestFlowNextRejectsStates(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		flow    Flow
+		step    Step
+		state   SkipState
+		allowed []SkipState
+		message string
+	}{
+		{
+			name:    "unexpected state",
+			flow:    FlowLogin,
+			step:    StepLoginVerify,
+			state:   SkipStateConfirmMobileOTP,
+			allowed: []SkipState{SkipStateAbhaSelect, SkipStateAbhaEnd},
+			message: `invalid skip state: unexpected skip state "confirm_mobile_otp" after abdm.login.LoginVerify in the login flow, expected one of abha_select, abha_end`,
+		},
+		{
+			name:    "unknown state",
+			flow:    FlowMobileRegistration,
+			step:    StepMobileCreatePHR,
+			state:   "abha_link_aadhaar",
+			allowed: []SkipState{SkipStateAbhaEnd},
+			message: `invalid skip state: unknown skip state "abha_link_aadhaar" after abdm.registration.MobileCreatePHR in the mobile_registration flow, expected one of abha_end`,
+		},
+		{
+			name:    "step outside the flow",
+			flow:    FlowLogin,
+			step:    StepAadhaarVerify,
+			state:   SkipStateAbhaEnd,
+			message: "invalid skip state: abdm.registration.AadhaarVerify is not a step of the login flow",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := tt.flow.Next(tt.step, tt.state)
+			if next != "" || !errors.Is(err, ErrInvalidSkipState) {
+				t.Fatalf("Next = %q, %v; want ErrInvalidSkipState", next, err)
+			}
+			var invalid *InvalidSkipStateError
+			if !errors.As(err, &invalid) {
+				t.Fatalf("err = %T, want *InvalidSkipStateError", err)
+			}
+			if invalid.Flow != tt.flow || invalid.Step != tt.step || invalid.State != tt.state || !slices.Equal(invalid.Allowed, tt.allowed) {
+				t.Errorf("err = %+v", invalid)
+			}
+			if err.Error() != tt.message {
+				t.Errorf("Error() = %q, want %q", err, tt.message)
+			}
+		})
+	}
+}
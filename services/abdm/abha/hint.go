@@ -0,0 +1,135 @@
+package abha
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OTPChannel is how an OTP was delivered
+type OTPChannel string
+
+const (
+	// OTPChannelUnknown is used when the hint does not tell the channel
+	OTPChannelUnknown OTPChannel = ""
+	OTPChannelSMS     OTPChannel = "sms"
+	OTPChannelEmail   OTPChannel = "email"
+)
+
+// OTPHint is the parsed hint returned by the calls that send an OTP, telling
+// where it was sent. The gateway words hints differently across login,
+// registration and KYC; OTPHint gives them one shape and one rendering.
+type OTPHint struct {
+	Channel OTPChannel
+	// Destination is the masked mobile number or email, e.g. ******1234 or
+	// ab****@gmail.com, or "" if the hint does not contain one
+	Destination string
+	// Expiry is the validity of the OTP stated by the hint, 0 if not stated
+	Expiry time.Duration
+	Raw    string // Hint as sent by the gateway
+}
+
+var (
+	// maskedPattern matches a masked mobile number or email, e.g. ******1234,
+	// XXXXXX1234 or ab****@gmail.com
+	maskedPattern = regexp.MustCompile(`[\w.+-]*(?:[*•]{2,}|[xX]{3,})[\w.+-]*(?:@[\w-]+(?:\.[\w-]+)+)?`)
+	// endingPattern matches the visible digits of a mobile number given in
+	// words, e.g. "ending with 1234"
+	endingPattern = regexp.MustCompile(`(?i)\bending(?:\s+(?:with|in))?\s+(\d{2,})\b`)
+	// expiryPattern matches the validity of an OTP, e.g. "valid for 10 minutes"
+	expiryPattern = regexp.MustCompile(`(?i)\b(?:valid|expires?|expiry)\D{0,20}?(\d+)\s*(seconds?|secs?|minutes?|mins?|hours?|hrs?)\b`)
+)
+
+// mobileMask is the mask put in front of the visible digits of a mobile
+// number given in words
+const mobileMask = "******"
+
+// ParseOTPHint parses a hint such as "OTP sent to mobile number ******1234,
+// valid for 10 minutes". Parts the hint does not contain are left empty; an
+// empty hint gives a zero OTPHint.
+func ParseOTPHint(hint string) OTPHint {
+	h := OTPHint{Raw: hint}
+	hint = strings.TrimSpace(hint)
+	if hint == "" {
+		return h
+	}
+
+	if dest := maskedPattern.FindString(hint); dest != "" && strings.ContainsAny(dest, "0123456789@") {
+		h.Destination = dest
+	} else if m := endingPattern.FindStringSubmatch(hint); m != nil {
+		h.Destination = mobileMask + m[1]
+	}
+
+	lower := strings.ToLower(hint)
+	switch {
+	case strings.Contains(h.Destination, "@"):
+		h.Channel = OTPChannelEmail
+	case h.Destination != "":
+		h.Channel = OTPChannelSMS
+	case strings.Contains(lower, "email") || strings.Contains(lower, "e-mail"):
+		h.Channel = OTPChannelEmail
+	case strings.Contains(lower, "mobile") || strings.Contains(lower, "phone") || strings.Contains(lower, "sms"):
+		h.Channel = OTPChannelSMS
+	}
+
+	if m := expiryPattern.FindStringSubmatch(hint); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err == nil {
+			h.Expiry = time.Duration(n) * expiryUnit(m[2])
+		}
+	}
+	return h
+}
+
+// expiryUnit returns the duration of a unit matched by expiryPattern
+func expiryUnit(unit string) time.Duration {
+	switch unit = strings.ToLower(unit); {
+	case strings.HasPrefix(unit, "h"):
+		return time.Hour
+	case strings.HasPrefix(unit, "m"):
+		return time.Minute
+	default:
+		return time.Second
+	}
+}
+
+// IsZero returns true if the hint was empty
+func (h OTPHint) IsZero() bool {
+	return strings.TrimSpace(h.Raw) == "" && h.Destination == "" && h.Channel == OTPChannelUnknown
+}
+
+// Suffix returns the visible trailing digits of a masked mobile number, e.g.
+// 1234 for ******1234, or "" for emails and hints without a number
+func (h OTPHint) Suffix() string {
+	if h.Channel != OTPChannelSMS {
+		return ""
+	}
+	i := len(h.Destination)
+	for i > 0 && h.Destination[i-1] >= '0' && h.Destination[i-1] <= '9' {
+		i--
+	}
+	return h.Destination[i:]
+}
+
+// String renders the hint for display, in the same words whichever call
+// returned it:
+//
+//	OTP sent to mobile ending 1234
+//	OTP sent to email ab****@gmail.com
+//	OTP sent to mobile
+//	OTP sent
+func (h OTPHint) String() string {
+	switch {
+	case h.Channel == OTPChannelSMS && h.Suffix() != "":
+		return "OTP sent to mobile ending " + h.Suffix()
+	case h.Channel == OTPChannelEmail && h.Destination != "":
+		return "OTP sent to email " + h.Destination
+	case h.Channel == OTPChannelSMS:
+		return "OTP sent to mobile"
+	case h.Channel == OTPChannelEmail:
+		return "OTP sent to email"
+	default:
+		return "OTP sent"
+	}
+}
@@ -0,0 +1,103 @@
+package abha
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseOTPHint(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		hint        string
+		channel     OTPChannel
+		destination string
+		expiry      time.Duration
+		rendered    string
+	}{
+		{
+			name:        "aadhaar registration",
+			hint:        "OTP sent to Aadhaar registered mobile number ending with ******1234",
+			channel:     OTPChannelSMS,
+			destination: "******1234",
+			rendered:    "OTP sent to mobile ending 1234",
+		},
+		{
+			name:        "aadhaar kyc",
+			hint:        "We just sent an OTP on the Mobile Number ******5678 linked with your Aadhaar.",
+			channel:     OTPChannelSMS,
+			destination: "******5678",
+			rendered:    "OTP sent to mobile ending 5678",
+		},
+		{
+			name:        "login with X mask",
+			hint:        "OTP sent to mobile number XXXXXX9012",
+			channel:     OTPChannelSMS,
+			destination: "XXXXXX9012",
+			rendered:    "OTP sent to mobile ending 9012",
+		},
+		{
+			name:        "mobile in words",
+			hint:        "OTP has been sent to the mobile ending in 4321, valid for 10 minutes",
+			channel:     OTPChannelSMS,
+			destination: "******4321",
+			expiry:      10 * time.Minute,
+			rendered:    "OTP sent to mobile ending 4321",
+		},
+		{
+			name:        "masked email",
+			hint:        "OTP sent successfully to the registered email ab****@gmail.com",
+			channel:     OTPChannelEmail,
+			destination: "ab****@gmail.com",
+			rendered:    "OTP sent to email ab****@gmail.com",
+		},
+		{
+			name:     "email without address",
+			hint:     "OTP sent to your registered e-mail",
+			channel:  OTPChannelEmail,
+			rendered: "OTP sent to email",
+		},
+		{
+			name:     "channel from words",
+			hint:     "OTP sent via SMS. It expires in 5 mins",
+			channel:  OTPChannelSMS,
+			expiry:   5 * time.Minute,
+			rendered: "OTP sent to mobile",
+		},
+		{
+			name:     "expiry in seconds",
+			hint:     "OTP valid for 600 seconds",
+			expiry:   600 * time.Second,
+			rendered: "OTP sent",
+		},
+		{
+			name:        "expiry in hours",
+			hint:        "OTP sent to ******1111, expiry 1 hr",
+			channel:     OTPChannelSMS,
+			destination: "******1111",
+			expiry:      time.Hour,
+			rendered:    "OTP sent to mobile ending 1111",
+		},
+		{
+			name:     "no mask",
+			hint:     "OTP sent successfully",
+			rendered: "OTP sent",
+		},
+		{
+			name:     "empty",
+			rendered: "OTP sent",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			h := ParseOTPHint(tt.hint)
+			if h.Channel != tt.channel || h.Destination != tt.destination || h.Expiry != tt.expiry || h.Raw != tt.hint {
+				t.Errorf("ParseOTPHint = %+v, want channel %q, destination %q, expiry %s", h, tt.channel, tt.destination, tt.expiry)
+			}
+			if got := h.String(); got != tt.rendered {
+				t.Errorf("String() = %q, want %q", got, tt.rendered)
+			}
+			if h.IsZero() != (tt.hint == "") {
+				t.Errorf("IsZero() = %v", h.IsZero())
+			}
+		})
+	}
+}
@@ -14,9 +14,8 @@ type Service struct {
 	http   *http.Client
 }
 
-// NewService creates a new login service instance
-func NewService(config interfaces.Config) *Service {
-	httpClient := http.NewClientFromInterface(config)
+// NewService creates a new login service instance sharing the given HTTP client
+func NewService(config interfaces.Config, httpClient *http.Client) *Service {
 	return &Service{
 		config: config,
 		http:   httpClient,
@@ -24,13 +23,15 @@ func NewService(config interfaces.Config) *Service {
 }
 
 // LoginInit generates OTP for login with different identifier methods
-func (s *Service) LoginInit(ctx context.Context, headers interfaces.Headers, req *InitLoginRequest) (*InitLoginResponse, error) {
+func (s *Service) LoginInit(ctx context.Context, headers interfaces.Headers, req *InitLoginRequest, opts ...interfaces.RequestOption) (*InitLoginResponse, error) {
 
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/profile/login/init",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.login.LoginInit",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/profile/login/init",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -45,13 +46,15 @@ func (s *Service) LoginInit(ctx context.Context, headers interfaces.Headers, req
 }
 
 // LoginVerify verifies the login OTP
-func (s *Service) LoginVerify(ctx context.Context, headers interfaces.Headers, req *VerifyLoginOTPRequest) (*VerifyLoginOTPResponse, error) {
+func (s *Service) LoginVerify(ctx context.Context, headers interfaces.Headers, req *VerifyLoginOTPRequest, opts ...interfaces.RequestOption) (*VerifyLoginOTPResponse, error) {
 
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/profile/login/verify",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.login.LoginVerify",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/profile/login/verify",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -66,12 +69,14 @@ func (s *Service) LoginVerify(ctx context.Context, headers interfaces.Headers, r
 }
 
 // LoginWithPHRAddress handles login using PHR address
-func (s *Service) LoginWithPHRAddress(ctx context.Context, headers interfaces.Headers, req *PhrAddressLoginRequest) (*PhrAddressLoginResponse, error) {
+func (s *Service) LoginWithPHRAddress(ctx context.Context, headers interfaces.Headers, req *PhrAddressLoginRequest, opts ...interfaces.RequestOption) (*PhrAddressLoginResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/profile/login/phr",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.login.LoginWithPHRAddress",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/profile/login/phr",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
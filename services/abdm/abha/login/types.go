@@ -1,6 +1,11 @@
 package login
 
-import "github.com/eka-care/eka-sdk-go/services/abdm/abha"
+import (
+	"encoding/json"
+
+	"github.com/eka-care/eka-sdk-go/internal/ptr"
+	"github.com/eka-care/eka-sdk-go/services/abdm/abha"
+)
 
 // InitLoginRequest represents the request for generating login OTP
 type InitLoginRequest struct {
@@ -19,8 +24,14 @@ const (
 
 // InitLoginResponse represents the response for login OTP generation
 type InitLoginResponse struct {
-	Hint  string `json:"hint"`
-	TxnID string `json:"txn_id"`
+	Hint  string                     `json:"hint"`
+	TxnID string                     `json:"txn_id"`
+	Extra map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
+}
+
+// OTPHint parses Hint, see abha.ParseOTPHint
+func (r *InitLoginResponse) OTPHint() abha.OTPHint {
+	return abha.ParseOTPHint(r.Hint)
 }
 
 // VerifyLoginOTPRequest represents the request for verifying login OTP
@@ -31,43 +42,65 @@ type VerifyLoginOTPRequest struct {
 
 // VerifyLoginOTPResponse represents the response for login OTP verification
 type VerifyLoginOTPResponse struct {
-	AbhaProfiles []AbhaProfile  `json:"abha_profiles"`
-	Eka          EkaIDs         `json:"eka"`
-	Hint         string         `json:"hint"`
-	Profile      Profile        `json:"profile"`
-	SkipState    abha.SkipState `json:"skip_state"`
-	TxnID        string         `json:"txn_id"`
+	AbhaProfiles []AbhaProfile              `json:"abha_profiles"`
+	Eka          EkaIDs                     `json:"eka"`
+	Hint         string                     `json:"hint"`
+	Profile      Profile                    `json:"profile"`
+	SkipState    abha.SkipState             `json:"skip_state"`
+	TxnID        string                     `json:"txn_id"`
+	Extra        map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // AbhaProfile represents ABHA profile information
 type AbhaProfile struct {
-	AbhaAddress string `json:"abha_address"`
-	KycVerified string `json:"kyc_verified"`
-	Name        string `json:"name"`
+	AbhaAddress string                     `json:"abha_address"`
+	KycVerified string                     `json:"kyc_verified"`
+	Name        string                     `json:"name"`
+	Extra       map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // EkaIDs represents Eka identification tokens
 type EkaIDs struct {
-	MinToken string  `json:"min_token"`
-	OID      *string `json:"oid,omitempty"`
-	UUID     *string `json:"uuid,omitempty"`
+	MinToken string                     `json:"min_token"`
+	OID      *string                    `json:"oid,omitempty"`
+	UUID     *string                    `json:"uuid,omitempty"`
+	Extra    map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // Profile represents user profile information
 type Profile struct {
-	AbhaAddress  string  `json:"abha_address"`
-	AbhaNumber   *string `json:"abha_number,omitempty"`
-	Address      *string `json:"address,omitempty"`
-	DayOfBirth   *int    `json:"day_of_birth,omitempty"`
-	FirstName    *string `json:"first_name,omitempty"`
-	Gender       string  `json:"gender"`
-	KycVerified  *bool   `json:"kyc_verified,omitempty"`
-	LastName     *string `json:"last_name,omitempty"`
-	MiddleName   *string `json:"middle_name,omitempty"`
-	Mobile       *string `json:"mobile,omitempty"`
-	MonthOfBirth *int    `json:"month_of_birth,omitempty"`
-	Pincode      *string `json:"pincode,omitempty"`
-	YearOfBirth  *int    `json:"year_of_birth,omitempty"`
+	AbhaAddress  string                     `json:"abha_address"`
+	AbhaNumber   *string                    `json:"abha_number,omitempty"`
+	Address      *string                    `json:"address,omitempty"`
+	DayOfBirth   *int                       `json:"day_of_birth,omitempty"`
+	FirstName    *string                    `json:"first_name,omitempty"`
+	Gender       string                     `json:"gender"`
+	KycVerified  *bool                      `json:"kyc_verified,omitempty"`
+	LastName     *string                    `json:"last_name,omitempty"`
+	MiddleName   *string                    `json:"middle_name,omitempty"`
+	Mobile       *string                    `json:"mobile,omitempty"`
+	MonthOfBirth *int                       `json:"month_of_birth,omitempty"`
+	Pincode      *string                    `json:"pincode,omitempty"`
+	YearOfBirth  *int                       `json:"year_of_birth,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
+}
+
+// Normalize converts the profile to the canonical abha.Profile
+func (p *Profile) Normalize() *abha.Profile {
+	return &abha.Profile{
+		AbhaAddress: p.AbhaAddress,
+		AbhaNumber:  ptr.Value(p.AbhaNumber),
+		FirstName:   ptr.Value(p.FirstName),
+		MiddleName:  ptr.Value(p.MiddleName),
+		LastName:    ptr.Value(p.LastName),
+		Gender:      abha.ParseGender(p.Gender),
+		DateOfBirth: abha.DateFromParts(p.YearOfBirth, p.MonthOfBirth, p.DayOfBirth),
+		Mobile:      ptr.Value(p.Mobile),
+		Address:     ptr.Value(p.Address),
+		Pincode:     ptr.Value(p.Pincode),
+		KycVerified: ptr.Value(p.KycVerified),
+		Extra:       p.Extra,
+	}
 }
 
 // PhrAddressLoginRequest represents the request for PHR address login
@@ -78,9 +111,10 @@ type PhrAddressLoginRequest struct {
 
 // PhrAddressLoginResponse represents the response for login
 type PhrAddressLoginResponse struct {
-	Eka       EkaIDs         `json:"eka"`
-	Hint      string         `json:"hint"`
-	Profile   Profile        `json:"profile"`
-	SkipState abha.SkipState `json:"skip_state"`
-	TxnID     string         `json:"txn_id"`
+	Eka       EkaIDs                     `json:"eka"`
+	Hint      string                     `json:"hint"`
+	Profile   Profile                    `json:"profile"`
+	SkipState abha.SkipState             `json:"skip_state"`
+	TxnID     string                     `json:"txn_id"`
+	Extra     map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
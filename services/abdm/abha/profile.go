@@ -0,0 +1,90 @@
+package abha
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Gender is the gender recorded in an ABHA profile
+type Gender string
+
+const (
+	// GenderUnknown is used when the gender is missing or not recognised
+	GenderUnknown Gender = ""
+	GenderMale    Gender = "M"
+	GenderFemale  Gender = "F"
+	GenderOther   Gender = "O"
+	// GenderTransgender is used by some ABDM flows in place of GenderOther
+	GenderTransgender Gender = "T"
+)
+
+// ParseGender parses an ABDM gender code or name, e.g. "M" or "female",
+// ignoring case; unrecognised values give GenderUnknown
+func ParseGender(s string) Gender {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "m", "male":
+		return GenderMale
+	case "f", "female":
+		return GenderFemale
+	case "o", "other", "others":
+		return GenderOther
+	case "t", "transgender":
+		return GenderTransgender
+	default:
+		return GenderUnknown
+	}
+}
+
+// String returns the ABDM gender code
+func (g Gender) String() string {
+	return string(g)
+}
+
+// IsKnown returns true if the gender is one of the defined codes
+func (g Gender) IsKnown() bool {
+	switch g {
+	case GenderMale, GenderFemale, GenderOther, GenderTransgender:
+		return true
+	}
+	return false
+}
+
+// Profile is the normalised ABHA profile returned, in different shapes, by
+// the login, registration and profile services. Missing values are empty.
+type Profile struct {
+	AbhaAddress string                     `json:"abha_address"`
+	AbhaNumber  string                     `json:"abha_number,omitempty"`
+	Name        string                     `json:"name,omitempty"` // Full name as sent by the gateway, if any
+	FirstName   string                     `json:"first_name,omitempty"`
+	MiddleName  string                     `json:"middle_name,omitempty"`
+	LastName    string                     `json:"last_name,omitempty"`
+	Gender      Gender                     `json:"gender,omitempty"`
+	DateOfBirth Date                       `json:"date_of_birth,omitzero"`
+	Email       string                     `json:"email,omitempty"`
+	Mobile      string                     `json:"mobile,omitempty"`
+	Address     string                     `json:"address,omitempty"`
+	Pincode     string                     `json:"pincode,omitempty"`
+	KycVerified bool                       `json:"kyc_verified,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-"` // Unknown fields of the source response
+}
+
+// FullName joins the first, middle and last names, or returns Name if they
+// are all empty
+func (p *Profile) FullName() string {
+	var parts []string
+	for _, part := range []string{p.FirstName, p.MiddleName, p.LastName} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) == 0 {
+		return strings.TrimSpace(p.Name)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Age returns the age in completed years at the given time, see Date.Age
+func (p *Profile) Age(at time.Time) (years int, exact bool) {
+	return p.DateOfBirth.Age(at)
+}
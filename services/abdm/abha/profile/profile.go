@@ -14,9 +14,8 @@ type Service struct {
 	http   *http.Client
 }
 
-// NewService creates a new profile service instance
-func NewService(config interfaces.Config) *Service {
-	httpClient := http.NewClientFromInterface(config)
+// NewService creates a new profile service instance sharing the given HTTP client
+func NewService(config interfaces.Config, httpClient *http.Client) *Service {
 	return &Service{
 		config: config,
 		http:   httpClient,
@@ -24,11 +23,13 @@ func NewService(config interfaces.Config) *Service {
 }
 
 // GetProfile retrieves the user's ABHA profile information
-func (s *Service) GetProfile(ctx context.Context, headers interfaces.Headers) (*ProfileResponse, error) {
+func (s *Service) GetProfile(ctx context.Context, headers interfaces.Headers, opts ...interfaces.RequestOption) (*ProfileResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "GET",
-		Path:    "/abdm/v1/profile",
-		Headers: headers,
+		Operation: "abdm.profile.GetProfile",
+		Method:    "GET",
+		Path:      "/abdm/v1/profile",
+		Headers:   headers,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -43,11 +44,13 @@ func (s *Service) GetProfile(ctx context.Context, headers interfaces.Headers) (*
 }
 
 // GetAssetCard retrieves the ABHA card as a binary image
-func (s *Service) GetAssetCard(ctx context.Context, headers interfaces.Headers, req *AssetRequest) (*AssetCardResponse, error) {
+func (s *Service) GetAssetCard(ctx context.Context, headers interfaces.Headers, req *AssetRequest, opts ...interfaces.RequestOption) (*AssetCardResponse, error) {
 	httpReq := &interfaces.HTTPRequest{
-		Method:  "GET",
-		Path:    "/abdm/v1/profile/asset/card",
-		Headers: headers,
+		Operation: "abdm.profile.GetAssetCard",
+		Method:    "GET",
+		Path:      "/abdm/v1/profile/asset/card",
+		Headers:   headers,
+		Options:   opts,
 	}
 
 	// Add query parameters if provided
@@ -70,11 +73,13 @@ func (s *Service) GetAssetCard(ctx context.Context, headers interfaces.Headers,
 }
 
 // GetAssetQR retrieves the ABHA QR code data as JSON
-func (s *Service) GetAssetQR(ctx context.Context, headers interfaces.Headers, req *AssetRequest) (*AssetQRResponse, error) {
+func (s *Service) GetAssetQR(ctx context.Context, headers interfaces.Headers, req *AssetRequest, opts ...interfaces.RequestOption) (*AssetQRResponse, error) {
 	httpReq := &interfaces.HTTPRequest{
-		Method:  "GET",
-		Path:    "/abdm/v1/profile/asset/qr",
-		Headers: headers,
+		Operation: "abdm.profile.GetAssetQR",
+		Method:    "GET",
+		Path:      "/abdm/v1/profile/asset/qr",
+		Headers:   headers,
+		Options:   opts,
 	}
 
 	// Add query parameters if provided
@@ -100,12 +105,14 @@ func (s *Service) GetAssetQR(ctx context.Context, headers interfaces.Headers, re
 }
 
 // UpdateProfile updates the user's ABHA profile information
-func (s *Service) UpdateProfile(ctx context.Context, headers interfaces.Headers, req *UpdateProfileRequest) error {
+func (s *Service) UpdateProfile(ctx context.Context, headers interfaces.Headers, req *UpdateProfileRequest, opts ...interfaces.RequestOption) error {
 	httpReq := &interfaces.HTTPRequest{
-		Method:  "PATCH",
-		Path:    "/abdm/v1/profile",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.profile.UpdateProfile",
+		Method:    "PATCH",
+		Path:      "/abdm/v1/profile",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	}
 
 	// Add query parameters if OID is provided
@@ -125,11 +132,13 @@ func (s *Service) UpdateProfile(ctx context.Context, headers interfaces.Headers,
 }
 
 // DeleteProfile deletes the user's ABHA profile and all associated data
-func (s *Service) DeleteProfile(ctx context.Context, headers interfaces.Headers, oid string) error {
+func (s *Service) DeleteProfile(ctx context.Context, headers interfaces.Headers, oid string, opts ...interfaces.RequestOption) error {
 	httpReq := &interfaces.HTTPRequest{
-		Method:  "DELETE",
-		Path:    "/abdm/v1/profile",
-		Headers: headers,
+		Operation: "abdm.profile.DeleteProfile",
+		Method:    "DELETE",
+		Path:      "/abdm/v1/profile",
+		Headers:   headers,
+		Options:   opts,
 	}
 
 	// Add query parameters if OID is provided
@@ -149,12 +158,14 @@ func (s *Service) DeleteProfile(ctx context.Context, headers interfaces.Headers,
 }
 
 // KYCInit initializes the KYC process by requesting an OTP
-func (s *Service) KYCInit(ctx context.Context, headers interfaces.Headers, req *KYCInitRequest) (*KYCInitResponse, error) {
+func (s *Service) KYCInit(ctx context.Context, headers interfaces.Headers, req *KYCInitRequest, opts ...interfaces.RequestOption) (*KYCInitResponse, error) {
 	httpReq := &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/v1/profile/kyc/init",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.profile.KYCInit",
+		Method:    "POST",
+		Path:      "/abdm/v1/profile/kyc/init",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	}
 
 	// Add query parameters if OID is provided
@@ -178,12 +189,14 @@ func (s *Service) KYCInit(ctx context.Context, headers interfaces.Headers, req *
 }
 
 // KYCResend resends the OTP for KYC verification
-func (s *Service) KYCResend(ctx context.Context, headers interfaces.Headers, req *KYCResendRequest) (*KYCResendResponse, error) {
+func (s *Service) KYCResend(ctx context.Context, headers interfaces.Headers, req *KYCResendRequest, opts ...interfaces.RequestOption) (*KYCResendResponse, error) {
 	httpReq := &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/v1/profile/kyc/resend",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.profile.KYCResend",
+		Method:    "POST",
+		Path:      "/abdm/v1/profile/kyc/resend",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	}
 
 	// Add query parameters if OID is provided
@@ -207,12 +220,14 @@ func (s *Service) KYCResend(ctx context.Context, headers interfaces.Headers, req
 }
 
 // KYCVerify verifies the OTP to complete the KYC process
-func (s *Service) KYCVerify(ctx context.Context, headers interfaces.Headers, req *KYCVerifyRequest) (*KYCVerifyResponse, error) {
+func (s *Service) KYCVerify(ctx context.Context, headers interfaces.Headers, req *KYCVerifyRequest, opts ...interfaces.RequestOption) (*KYCVerifyResponse, error) {
 	httpReq := &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/v1/profile/kyc/verify",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.profile.KYCVerify",
+		Method:    "POST",
+		Path:      "/abdm/v1/profile/kyc/verify",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	}
 
 	// Add query parameters if OID is provided
@@ -236,12 +251,14 @@ func (s *Service) KYCVerify(ctx context.Context, headers interfaces.Headers, req
 }
 
 // SessionInit initializes a new session for the user
-func (s *Service) SessionInit(ctx context.Context, headers interfaces.Headers, req *SessionInitRequest) (*SessionInitResponse, error) {
+func (s *Service) SessionInit(ctx context.Context, headers interfaces.Headers, req *SessionInitRequest, opts ...interfaces.RequestOption) (*SessionInitResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/v1/session/init",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.profile.SessionInit",
+		Method:    "POST",
+		Path:      "/abdm/v1/session/init",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -256,12 +273,14 @@ func (s *Service) SessionInit(ctx context.Context, headers interfaces.Headers, r
 }
 
 // SessionVerify verifies the session using OTP
-func (s *Service) SessionVerify(ctx context.Context, headers interfaces.Headers, req *SessionVerifyRequest) (*SessionVerifyResponse, error) {
+func (s *Service) SessionVerify(ctx context.Context, headers interfaces.Headers, req *SessionVerifyRequest, opts ...interfaces.RequestOption) (*SessionVerifyResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/v1/session/verify",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.profile.SessionVerify",
+		Method:    "POST",
+		Path:      "/abdm/v1/session/verify",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -1,23 +1,58 @@
 package profile
 
+import (
+	"encoding/json"
+
+	"github.com/eka-care/eka-sdk-go/internal/ptr"
+	"github.com/eka-care/eka-sdk-go/services/abdm/abha"
+)
+
 // ProfileResponse represents user profile information
 type ProfileResponse struct {
-	AbhaAddress  string  `json:"abha_address"`
-	AbhaNumber   *string `json:"abha_number,omitempty"`
-	Name         *string `json:"name,omitempty"`
-	FirstName    *string `json:"first_name,omitempty"`
-	MiddleName   *string `json:"middle_name,omitempty"`
-	LastName     *string `json:"last_name,omitempty"`
-	Gender       string  `json:"gender"`
-	DateOfBirth  *string `json:"date_of_birth,omitempty"`
-	YearOfBirth  *int    `json:"year_of_birth,omitempty"`
-	MonthOfBirth *int    `json:"month_of_birth,omitempty"`
-	DayOfBirth   *int    `json:"day_of_birth,omitempty"`
-	Email        *string `json:"email,omitempty"`
-	Mobile       *string `json:"mobile,omitempty"`
-	Address      *string `json:"address,omitempty"`
-	Pincode      *string `json:"pincode,omitempty"`
-	KycVerified  *bool   `json:"kyc_verified,omitempty"`
+	AbhaAddress  string                     `json:"abha_address"`
+	AbhaNumber   *string                    `json:"abha_number,omitempty"`
+	Name         *string                    `json:"name,omitempty"`
+	FirstName    *string                    `json:"first_name,omitempty"`
+	MiddleName   *string                    `json:"middle_name,omitempty"`
+	LastName     *string                    `json:"last_name,omitempty"`
+	Gender       string                     `json:"gender"`
+	DateOfBirth  *string                    `json:"date_of_birth,omitempty"`
+	YearOfBirth  *int                       `json:"year_of_birth,omitempty"`
+	MonthOfBirth *int                       `json:"month_of_birth,omitempty"`
+	DayOfBirth   *int                       `json:"day_of_birth,omitempty"`
+	Email        *string                    `json:"email,omitempty"`
+	Mobile       *string                    `json:"mobile,omitempty"`
+	Address      *string                    `json:"address,omitempty"`
+	Pincode      *string                    `json:"pincode,omitempty"`
+	KycVerified  *bool                      `json:"kyc_verified,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
+}
+
+// Normalize converts the profile to the canonical abha.Profile. The date of
+// birth is taken from the day, month and year fields, or parsed from
+// DateOfBirth when they are missing.
+func (p *ProfileResponse) Normalize() *abha.Profile {
+	dob := abha.DateFromParts(p.YearOfBirth, p.MonthOfBirth, p.DayOfBirth)
+	if dob.IsZero() && p.DateOfBirth != nil {
+		// An unparseable date is left unknown rather than failing the conversion
+		dob, _ = abha.ParseDate(*p.DateOfBirth)
+	}
+	return &abha.Profile{
+		AbhaAddress: p.AbhaAddress,
+		AbhaNumber:  ptr.Value(p.AbhaNumber),
+		Name:        ptr.Value(p.Name),
+		FirstName:   ptr.Value(p.FirstName),
+		MiddleName:  ptr.Value(p.MiddleName),
+		LastName:    ptr.Value(p.LastName),
+		Gender:      abha.ParseGender(p.Gender),
+		DateOfBirth: dob,
+		Email:       ptr.Value(p.Email),
+		Mobile:      ptr.Value(p.Mobile),
+		Address:     ptr.Value(p.Address),
+		Pincode:     ptr.Value(p.Pincode),
+		KycVerified: ptr.Value(p.KycVerified),
+		Extra:       p.Extra,
+	}
 }
 
 // AssetCardResponse represents ABHA card asset response
@@ -29,19 +64,20 @@ type AssetCardResponse struct {
 
 // AssetQRResponse represents ABHA QR code asset response
 type AssetQRResponse struct {
-	AbhaAddress  string `json:"abha_address,omitempty"`
-	DistName     string `json:"dist name,omitempty"`
-	DistrictLGD  string `json:"distlgd,omitempty"`
-	DistrictName string `json:"district_name,omitempty"`
-	DOB          string `json:"dob,omitempty"`
-	Gender       string `json:"gender,omitempty"`
-	HID          string `json:"hid,omitempty"`
-	HIDN         string `json:"hidn,omitempty"`
-	Mobile       string `json:"mobile,omitempty"`
-	Name         string `json:"name,omitempty"`
-	PHR          string `json:"phr,omitempty"`
-	StateName    string `json:"state name,omitempty"`
-	StateLGD     string `json:"statelgd,omitempty"`
+	AbhaAddress  string                     `json:"abha_address,omitempty"`
+	DistName     string                     `json:"dist name,omitempty"`
+	DistrictLGD  string                     `json:"distlgd,omitempty"`
+	DistrictName string                     `json:"district_name,omitempty"`
+	DOB          string                     `json:"dob,omitempty"`
+	Gender       string                     `json:"gender,omitempty"`
+	HID          string                     `json:"hid,omitempty"`
+	HIDN         string                     `json:"hidn,omitempty"`
+	Mobile       string                     `json:"mobile,omitempty"`
+	Name         string                     `json:"name,omitempty"`
+	PHR          string                     `json:"phr,omitempty"`
+	StateName    string                     `json:"state name,omitempty"`
+	StateLGD     string                     `json:"statelgd,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // SessionInitRequest represents session initialization request
@@ -51,7 +87,8 @@ type SessionInitRequest struct {
 
 // SessionInitResponse represents session initialization response
 type SessionInitResponse struct {
-	TxnID string `json:"txn_id"`
+	TxnID string                     `json:"txn_id"`
+	Extra map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // SessionVerifyRequest represents session verification request
@@ -62,8 +99,9 @@ type SessionVerifyRequest struct {
 
 // SessionVerifyResponse represents session verification response
 type SessionVerifyResponse struct {
-	Token        string  `json:"token"`
-	RefreshToken *string `json:"refresh_token,omitempty"`
+	Token        string                     `json:"token"`
+	RefreshToken *string                    `json:"refresh_token,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // AssetRequest represents request parameters for asset generation
@@ -95,7 +133,14 @@ type KYCInitRequest struct {
 
 // KYCInitResponse represents the response from KYC initialization
 type KYCInitResponse struct {
-	TxnID string `json:"txn_id"`
+	TxnID string                     `json:"txn_id"`
+	Hint  string                     `json:"hint,omitempty"`
+	Extra map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
+}
+
+// OTPHint parses Hint, see abha.ParseOTPHint
+func (r *KYCInitResponse) OTPHint() abha.OTPHint {
+	return abha.ParseOTPHint(r.Hint)
 }
 
 // KYCResendRequest represents the request body for KYC OTP resend
@@ -106,7 +151,14 @@ type KYCResendRequest struct {
 
 // KYCResendResponse represents the response from KYC OTP resend
 type KYCResendResponse struct {
-	TxnID string `json:"txn_id"`
+	TxnID string                     `json:"txn_id"`
+	Hint  string                     `json:"hint,omitempty"`
+	Extra map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
+}
+
+// OTPHint parses Hint, see abha.ParseOTPHint
+func (r *KYCResendResponse) OTPHint() abha.OTPHint {
+	return abha.ParseOTPHint(r.Hint)
 }
 
 // KYCVerifyRequest represents the request body for KYC verification
@@ -119,5 +171,6 @@ type KYCVerifyRequest struct {
 
 // KYCVerifyResponse represents the response from KYC verification
 type KYCVerifyResponse struct {
-	TxnID string `json:"txn_id"`
+	TxnID string                     `json:"txn_id"`
+	Extra map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
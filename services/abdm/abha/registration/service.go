@@ -14,10 +14,8 @@ type Service struct {
 	http   *http.Client
 }
 
-// NewService creates a new registration service
-func NewService(config interfaces.Config) *Service {
-	httpClient := http.NewClientFromInterface(config)
-
+// NewService creates a new registration service sharing the given HTTP client
+func NewService(config interfaces.Config, httpClient *http.Client) *Service {
 	return &Service{
 		config: config,
 		http:   httpClient,
@@ -29,12 +27,14 @@ func NewService(config interfaces.Config) *Service {
 // ===============================
 
 // AadhaarInit initiates the Aadhaar registration process
-func (s *Service) AadhaarInit(ctx context.Context, headers interfaces.Headers, req InitRequest) (*InitResponse, error) {
+func (s *Service) AadhaarInit(ctx context.Context, headers interfaces.Headers, req InitRequest, opts ...interfaces.RequestOption) (*InitResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/registration/aadhaar/init",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.registration.AadhaarInit",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/registration/aadhaar/init",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -49,12 +49,14 @@ func (s *Service) AadhaarInit(ctx context.Context, headers interfaces.Headers, r
 }
 
 // AadhaarVerify verifies the Aadhaar OTP
-func (s *Service) AadhaarVerify(ctx context.Context, headers interfaces.Headers, req VerifyRequest) (*VerifyResponse, error) {
+func (s *Service) AadhaarVerify(ctx context.Context, headers interfaces.Headers, req VerifyRequest, opts ...interfaces.RequestOption) (*VerifyResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/registration/aadhaar/verify",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.registration.AadhaarVerify",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/registration/aadhaar/verify",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -69,12 +71,14 @@ func (s *Service) AadhaarVerify(ctx context.Context, headers interfaces.Headers,
 }
 
 // AadhaarResend resends the Aadhaar OTP
-func (s *Service) AadhaarResend(ctx context.Context, headers interfaces.Headers, req ResendRequest) (*ResendResponse, error) {
+func (s *Service) AadhaarResend(ctx context.Context, headers interfaces.Headers, req ResendRequest, opts ...interfaces.RequestOption) (*ResendResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/registration/aadhaar/resend",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.registration.AadhaarResend",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/registration/aadhaar/resend",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -89,13 +93,15 @@ func (s *Service) AadhaarResend(ctx context.Context, headers interfaces.Headers,
 }
 
 // AadhaarMobileVerify verifies mobile OTP in Aadhaar registration flow
-func (s *Service) AadhaarMobileVerify(ctx context.Context, headers interfaces.Headers, oid string, req MobileVerifyRequest) (*MobileVerifyResponse, error) {
+func (s *Service) AadhaarMobileVerify(ctx context.Context, headers interfaces.Headers, oid string, req MobileVerifyRequest, opts ...interfaces.RequestOption) (*MobileVerifyResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/registration/aadhaar/mobile/verify",
-		Headers: headers,
-		Body:    req,
-		Params:  map[string]string{"oid": oid},
+		Operation: "abdm.registration.AadhaarMobileVerify",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/registration/aadhaar/mobile/verify",
+		Headers:   headers,
+		Body:      req,
+		Params:    map[string]string{"oid": oid},
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -110,13 +116,15 @@ func (s *Service) AadhaarMobileVerify(ctx context.Context, headers interfaces.He
 }
 
 // AadhaarMobileResend resends mobile OTP in Aadhaar registration flow
-func (s *Service) AadhaarMobileResend(ctx context.Context, headers interfaces.Headers, oid string, req MobileResendRequest) (*MobileResendResponse, error) {
+func (s *Service) AadhaarMobileResend(ctx context.Context, headers interfaces.Headers, oid string, req MobileResendRequest, opts ...interfaces.RequestOption) (*MobileResendResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/registration/aadhaar/mobile/resend",
-		Headers: headers,
-		Body:    req,
-		Params:  map[string]string{"oid": oid},
+		Operation: "abdm.registration.AadhaarMobileResend",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/registration/aadhaar/mobile/resend",
+		Headers:   headers,
+		Body:      req,
+		Params:    map[string]string{"oid": oid},
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -131,12 +139,14 @@ func (s *Service) AadhaarMobileResend(ctx context.Context, headers interfaces.He
 }
 
 // AadhaarCreatePHR creates a new ABHA address via Aadhaar
-func (s *Service) AadhaarCreatePHR(ctx context.Context, headers interfaces.Headers, req CreateRequest) (*CreateResponse, error) {
+func (s *Service) AadhaarCreatePHR(ctx context.Context, headers interfaces.Headers, req CreateRequest, opts ...interfaces.RequestOption) (*CreateResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/registration/aadhaar/create-phr",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.registration.AadhaarCreatePHR",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/registration/aadhaar/create-phr",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -147,6 +157,8 @@ func (s *Service) AadhaarCreatePHR(ctx context.Context, headers interfaces.Heade
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
+	s.abhaCreated(ctx, "abdm.registration.AadhaarCreatePHR", resp.Patient, result.Profile, result.Eka)
+
 	return &result, nil
 }
 
@@ -155,12 +167,14 @@ func (s *Service) AadhaarCreatePHR(ctx context.Context, headers interfaces.Heade
 // ===============================
 
 // MobileInit initiates the mobile registration process
-func (s *Service) MobileInit(ctx context.Context, headers interfaces.Headers, req MobileInitRequest) (*MobileInitResponse, error) {
+func (s *Service) MobileInit(ctx context.Context, headers interfaces.Headers, req MobileInitRequest, opts ...interfaces.RequestOption) (*MobileInitResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/registration/mobile/init",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.registration.MobileInit",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/registration/mobile/init",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -175,12 +189,14 @@ func (s *Service) MobileInit(ctx context.Context, headers interfaces.Headers, re
 }
 
 // MobileVerify verifies the mobile OTP
-func (s *Service) MobileVerify(ctx context.Context, headers interfaces.Headers, req MobileVerifyOTPRequest) (*MobileVerifyOTPResponse, error) {
+func (s *Service) MobileVerify(ctx context.Context, headers interfaces.Headers, req MobileVerifyOTPRequest, opts ...interfaces.RequestOption) (*MobileVerifyOTPResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/registration/mobile/verify",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.registration.MobileVerify",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/registration/mobile/verify",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -195,12 +211,14 @@ func (s *Service) MobileVerify(ctx context.Context, headers interfaces.Headers,
 }
 
 // MobileResend resends the mobile OTP
-func (s *Service) MobileResend(ctx context.Context, headers interfaces.Headers, req MobileResendOTPRequest) (*MobileResendOTPResponse, error) {
+func (s *Service) MobileResend(ctx context.Context, headers interfaces.Headers, req MobileResendOTPRequest, opts ...interfaces.RequestOption) (*MobileResendOTPResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/registration/mobile/resend",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.registration.MobileResend",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/registration/mobile/resend",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -215,12 +233,14 @@ func (s *Service) MobileResend(ctx context.Context, headers interfaces.Headers,
 }
 
 // MobileCreatePHR creates a new ABHA address via mobile
-func (s *Service) MobileCreatePHR(ctx context.Context, headers interfaces.Headers, req MobileCreateRequest) (*MobileCreateResponse, error) {
+func (s *Service) MobileCreatePHR(ctx context.Context, headers interfaces.Headers, req MobileCreateRequest, opts ...interfaces.RequestOption) (*MobileCreateResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/registration/mobile/create-phr",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.registration.MobileCreatePHR",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/registration/mobile/create-phr",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -231,6 +251,8 @@ func (s *Service) MobileCreatePHR(ctx context.Context, headers interfaces.Header
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
+	s.abhaCreated(ctx, "abdm.registration.MobileCreatePHR", resp.Patient, result.Profile, result.Eka)
+
 	return &result, nil
 }
 
@@ -239,12 +261,14 @@ func (s *Service) MobileCreatePHR(ctx context.Context, headers interfaces.Header
 // ===============================
 
 // CheckAbhaAddressExists checks if an ABHA address already exists
-func (s *Service) CheckAbhaAddressExists(ctx context.Context, headers interfaces.Headers, req DoesHealthIdExistRequest) (*DoesHealthIdExistResponse, error) {
+func (s *Service) CheckAbhaAddressExists(ctx context.Context, headers interfaces.Headers, req DoesHealthIdExistRequest, opts ...interfaces.RequestOption) (*DoesHealthIdExistResponse, error) {
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "POST",
-		Path:    "/abdm/na/v1/registration/phr/check",
-		Headers: headers,
-		Body:    req,
+		Operation: "abdm.registration.CheckAbhaAddressExists",
+		Method:    "POST",
+		Path:      "/abdm/na/v1/registration/phr/check",
+		Headers:   headers,
+		Body:      req,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -259,7 +283,7 @@ func (s *Service) CheckAbhaAddressExists(ctx context.Context, headers interfaces
 }
 
 // SuggestAbhaAddress gets suggested ABHA addresses based on user details
-func (s *Service) SuggestAbhaAddress(ctx context.Context, headers interfaces.Headers, firstName, middleName, lastName, dob, transactionID string) (*SuggestHealthIdResponse, error) {
+func (s *Service) SuggestAbhaAddress(ctx context.Context, headers interfaces.Headers, firstName, middleName, lastName, dob, transactionID string, opts ...interfaces.RequestOption) (*SuggestHealthIdResponse, error) {
 	params := map[string]string{
 		"fn":            firstName,
 		"dob":           dob,
@@ -273,10 +297,12 @@ func (s *Service) SuggestAbhaAddress(ctx context.Context, headers interfaces.Hea
 	}
 
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "GET",
-		Path:    "/abdm/na/v1/registration/suggest",
-		Headers: headers,
-		Params:  params,
+		Operation: "abdm.registration.SuggestAbhaAddress",
+		Method:    "GET",
+		Path:      "/abdm/na/v1/registration/suggest",
+		Headers:   headers,
+		Params:    params,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -291,12 +317,14 @@ func (s *Service) SuggestAbhaAddress(ctx context.Context, headers interfaces.Hea
 }
 
 // GetPincodeDetails fetches pincode details
-func (s *Service) GetPincodeDetails(ctx context.Context, headers interfaces.Headers, pincode string) (*PincodeData, error) {
+func (s *Service) GetPincodeDetails(ctx context.Context, headers interfaces.Headers, pincode string, opts ...interfaces.RequestOption) (*PincodeData, error) {
 	path := fmt.Sprintf("/abdm/v1/registration/pincode/%s", pincode)
 	resp, err := s.http.Do(ctx, &interfaces.HTTPRequest{
-		Method:  "GET",
-		Path:    path,
-		Headers: headers,
+		Operation: "abdm.registration.GetPincodeDetails",
+		Method:    "GET",
+		Path:      path,
+		Headers:   headers,
+		Options:   opts,
 	})
 	if err != nil {
 		return nil, err
@@ -309,3 +337,25 @@ func (s *Service) GetPincodeDetails(ctx context.Context, headers interfaces.Head
 
 	return &result, nil
 }
+
+// abhaCreated calls the OnAbhaCreated hook for a newly created ABHA address;
+// patient is the merged patient headers the call was sent with
+func (s *Service) abhaCreated(ctx context.Context, operation string, patient interfaces.Headers, profile *ProfileResponse, eka *EkaIds) {
+	hooks := s.config.GetHooks()
+	if hooks == nil || hooks.OnAbhaCreated == nil || profile == nil {
+		return
+	}
+
+	event := interfaces.AbhaCreatedEvent{
+		Operation:   operation,
+		AbhaAddress: profile.AbhaAddress,
+		Patient:     patient,
+	}
+	if profile.AbhaNumber != nil {
+		event.AbhaNumber = *profile.AbhaNumber
+	}
+	if eka != nil && eka.OID != nil {
+		event.OID = *eka.OID
+	}
+	hooks.OnAbhaCreated(ctx, event)
+}
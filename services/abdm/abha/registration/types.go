@@ -1,6 +1,11 @@
 package registration
 
-import "github.com/eka-care/eka-sdk-go/services/abdm/abha"
+import (
+	"encoding/json"
+
+	"github.com/eka-care/eka-sdk-go/internal/ptr"
+	"github.com/eka-care/eka-sdk-go/services/abdm/abha"
+)
 
 // ===============================
 // Aadhaar Registration Types
@@ -13,8 +18,14 @@ type InitRequest struct {
 
 // InitResponse represents the response from Aadhaar init
 type InitResponse struct {
-	TxnID string  `json:"txn_id"`
-	Hint  *string `json:"hint,omitempty"`
+	TxnID string                     `json:"txn_id"`
+	Hint  *string                    `json:"hint,omitempty"`
+	Extra map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
+}
+
+// OTPHint parses Hint, see abha.ParseOTPHint
+func (r *InitResponse) OTPHint() abha.OTPHint {
+	return abha.ParseOTPHint(ptr.Value(r.Hint))
 }
 
 // VerifyRequest represents the request to verify Aadhaar OTP
@@ -26,13 +37,19 @@ type VerifyRequest struct {
 
 // VerifyResponse represents the response from Aadhaar verify
 type VerifyResponse struct {
-	TxnID        string           `json:"txn_id"`
-	SkipState    abha.SkipState   `json:"skip_state"`
-	Profile      *ProfileResponse `json:"profile,omitempty"`
-	Token        *string          `json:"token,omitempty"`
-	RefreshToken *string          `json:"refresh_token,omitempty"`
-	Eka          *EkaIds          `json:"eka,omitempty"`
-	Hint         *string          `json:"hint,omitempty"`
+	TxnID        string                     `json:"txn_id"`
+	SkipState    abha.SkipState             `json:"skip_state"`
+	Profile      *ProfileResponse           `json:"profile,omitempty"`
+	Token        *string                    `json:"token,omitempty"`
+	RefreshToken *string                    `json:"refresh_token,omitempty"`
+	Eka          *EkaIds                    `json:"eka,omitempty"`
+	Hint         *string                    `json:"hint,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
+}
+
+// OTPHint parses Hint, see abha.ParseOTPHint
+func (r *VerifyResponse) OTPHint() abha.OTPHint {
+	return abha.ParseOTPHint(ptr.Value(r.Hint))
 }
 
 // ResendRequest represents the request to resend Aadhaar OTP
@@ -42,8 +59,14 @@ type ResendRequest struct {
 
 // ResendResponse represents the response from Aadhaar resend OTP
 type ResendResponse struct {
-	TxnID string  `json:"txn_id"`
-	Hint  *string `json:"hint,omitempty"`
+	TxnID string                     `json:"txn_id"`
+	Hint  *string                    `json:"hint,omitempty"`
+	Extra map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
+}
+
+// OTPHint parses Hint, see abha.ParseOTPHint
+func (r *ResendResponse) OTPHint() abha.OTPHint {
+	return abha.ParseOTPHint(ptr.Value(r.Hint))
 }
 
 // MobileVerifyRequest represents the request to verify mobile OTP in Aadhaar flow
@@ -54,13 +77,14 @@ type MobileVerifyRequest struct {
 
 // MobileVerifyResponse represents the response from mobile verify in Aadhaar flow
 type MobileVerifyResponse struct {
-	TxnID        string           `json:"txn_id"`
-	SkipState    abha.SkipState   `json:"skip_state"`
-	Profile      *ProfileResponse `json:"profile,omitempty"`
-	Token        *string          `json:"token,omitempty"`
-	RefreshToken *string          `json:"refresh_token,omitempty"`
-	Eka          *EkaIds          `json:"eka,omitempty"`
-	Hint         *string          `json:"hint,omitempty"`
+	TxnID        string                     `json:"txn_id"`
+	SkipState    abha.SkipState             `json:"skip_state"`
+	Profile      *ProfileResponse           `json:"profile,omitempty"`
+	Token        *string                    `json:"token,omitempty"`
+	RefreshToken *string                    `json:"refresh_token,omitempty"`
+	Eka          *EkaIds                    `json:"eka,omitempty"`
+	Hint         *string                    `json:"hint,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // MobileResendRequest represents the request to resend mobile OTP in Aadhaar flow
@@ -70,8 +94,14 @@ type MobileResendRequest struct {
 
 // MobileResendResponse represents the response from mobile resend OTP in Aadhaar flow
 type MobileResendResponse struct {
-	TxnID string  `json:"txn_id"`
-	Hint  *string `json:"hint,omitempty"`
+	TxnID string                     `json:"txn_id"`
+	Hint  *string                    `json:"hint,omitempty"`
+	Extra map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
+}
+
+// OTPHint parses Hint, see abha.ParseOTPHint
+func (r *MobileResendResponse) OTPHint() abha.OTPHint {
+	return abha.ParseOTPHint(ptr.Value(r.Hint))
 }
 
 // CreateRequest represents the request to create ABHA address via Aadhaar
@@ -82,13 +112,14 @@ type CreateRequest struct {
 
 // CreateResponse represents the response from ABHA address creation via Aadhaar
 type CreateResponse struct {
-	TxnID        string           `json:"txn_id"`
-	SkipState    abha.SkipState   `json:"skip_state"`
-	Profile      *ProfileResponse `json:"profile,omitempty"`
-	Token        *string          `json:"token,omitempty"`
-	RefreshToken *string          `json:"refresh_token,omitempty"`
-	Eka          *EkaIds          `json:"eka,omitempty"`
-	Hint         *string          `json:"hint,omitempty"`
+	TxnID        string                     `json:"txn_id"`
+	SkipState    abha.SkipState             `json:"skip_state"`
+	Profile      *ProfileResponse           `json:"profile,omitempty"`
+	Token        *string                    `json:"token,omitempty"`
+	RefreshToken *string                    `json:"refresh_token,omitempty"`
+	Eka          *EkaIds                    `json:"eka,omitempty"`
+	Hint         *string                    `json:"hint,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // ===============================
@@ -102,8 +133,14 @@ type MobileInitRequest struct {
 
 // MobileInitResponse represents the response from mobile init
 type MobileInitResponse struct {
-	TxnID string  `json:"txn_id"`
-	Hint  *string `json:"hint,omitempty"`
+	TxnID string                     `json:"txn_id"`
+	Hint  *string                    `json:"hint,omitempty"`
+	Extra map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
+}
+
+// OTPHint parses Hint, see abha.ParseOTPHint
+func (r *MobileInitResponse) OTPHint() abha.OTPHint {
+	return abha.ParseOTPHint(ptr.Value(r.Hint))
 }
 
 // MobileVerifyOTPRequest represents the request to verify mobile OTP
@@ -114,10 +151,11 @@ type MobileVerifyOTPRequest struct {
 
 // MobileVerifyOTPResponse represents the response from mobile verify OTP
 type MobileVerifyOTPResponse struct {
-	TxnID        string              `json:"txn_id"`
-	SkipState    abha.SkipState      `json:"skip_state"`
-	AbhaProfiles []VerifyAbhaProfile `json:"abha_profiles,omitempty"`
-	Eka          *EkaIds             `json:"eka,omitempty"`
+	TxnID        string                     `json:"txn_id"`
+	SkipState    abha.SkipState             `json:"skip_state"`
+	AbhaProfiles []VerifyAbhaProfile        `json:"abha_profiles,omitempty"`
+	Eka          *EkaIds                    `json:"eka,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // MobileResendOTPRequest represents the request to resend mobile OTP
@@ -127,8 +165,14 @@ type MobileResendOTPRequest struct {
 
 // MobileResendOTPResponse represents the response from mobile resend OTP
 type MobileResendOTPResponse struct {
-	TxnID string `json:"txn_id"`
-	Hint  string `json:"hint"`
+	TxnID string                     `json:"txn_id"`
+	Hint  string                     `json:"hint"`
+	Extra map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
+}
+
+// OTPHint parses Hint, see abha.ParseOTPHint
+func (r *MobileResendOTPResponse) OTPHint() abha.OTPHint {
+	return abha.ParseOTPHint(r.Hint)
 }
 
 // MobileCreateRequest represents the request to create ABHA address via mobile
@@ -140,12 +184,13 @@ type MobileCreateRequest struct {
 
 // MobileCreateResponse represents the response from ABHA address creation via mobile
 type MobileCreateResponse struct {
-	SkipState    abha.SkipState   `json:"skip_state"`
-	Success      bool             `json:"success"`
-	Profile      *ProfileResponse `json:"profile,omitempty"`
-	Token        *string          `json:"token,omitempty"`
-	RefreshToken *string          `json:"refresh_token,omitempty"`
-	Eka          *EkaIds          `json:"eka,omitempty"`
+	SkipState    abha.SkipState             `json:"skip_state"`
+	Success      bool                       `json:"success"`
+	Profile      *ProfileResponse           `json:"profile,omitempty"`
+	Token        *string                    `json:"token,omitempty"`
+	RefreshToken *string                    `json:"refresh_token,omitempty"`
+	Eka          *EkaIds                    `json:"eka,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // ===============================
@@ -154,19 +199,38 @@ type MobileCreateResponse struct {
 
 // ProfileResponse represents the ABHA profile
 type ProfileResponse struct {
-	AbhaAddress  string  `json:"abha_address"`
-	AbhaNumber   *string `json:"abha_number,omitempty"`
-	FirstName    *string `json:"first_name,omitempty"`
-	MiddleName   *string `json:"middle_name,omitempty"`
-	LastName     *string `json:"last_name,omitempty"`
-	Gender       string  `json:"gender"`
-	YearOfBirth  *int    `json:"year_of_birth,omitempty"`
-	MonthOfBirth *int    `json:"month_of_birth,omitempty"`
-	DayOfBirth   *int    `json:"day_of_birth,omitempty"`
-	Mobile       *string `json:"mobile,omitempty"`
-	Address      *string `json:"address,omitempty"`
-	Pincode      *string `json:"pincode,omitempty"`
-	KycVerified  *bool   `json:"kyc_verified,omitempty"`
+	AbhaAddress  string                     `json:"abha_address"`
+	AbhaNumber   *string                    `json:"abha_number,omitempty"`
+	FirstName    *string                    `json:"first_name,omitempty"`
+	MiddleName   *string                    `json:"middle_name,omitempty"`
+	LastName     *string                    `json:"last_name,omitempty"`
+	Gender       string                     `json:"gender"`
+	YearOfBirth  *int                       `json:"year_of_birth,omitempty"`
+	MonthOfBirth *int                       `json:"month_of_birth,omitempty"`
+	DayOfBirth   *int                       `json:"day_of_birth,omitempty"`
+	Mobile       *string                    `json:"mobile,omitempty"`
+	Address      *string                    `json:"address,omitempty"`
+	Pincode      *string                    `json:"pincode,omitempty"`
+	KycVerified  *bool                      `json:"kyc_verified,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
+}
+
+// Normalize converts the profile to the canonical abha.Profile
+func (p *ProfileResponse) Normalize() *abha.Profile {
+	return &abha.Profile{
+		AbhaAddress: p.AbhaAddress,
+		AbhaNumber:  ptr.Value(p.AbhaNumber),
+		FirstName:   ptr.Value(p.FirstName),
+		MiddleName:  ptr.Value(p.MiddleName),
+		LastName:    ptr.Value(p.LastName),
+		Gender:      abha.ParseGender(p.Gender),
+		DateOfBirth: abha.DateFromParts(p.YearOfBirth, p.MonthOfBirth, p.DayOfBirth),
+		Mobile:      ptr.Value(p.Mobile),
+		Address:     ptr.Value(p.Address),
+		Pincode:     ptr.Value(p.Pincode),
+		KycVerified: ptr.Value(p.KycVerified),
+		Extra:       p.Extra,
+	}
 }
 
 // ProfileDetailsRequest represents profile details for requests
@@ -184,16 +248,18 @@ type ProfileDetailsRequest struct {
 
 // EkaIds represents the Eka platform identifiers
 type EkaIds struct {
-	OID      *string `json:"oid,omitempty"`
-	UUID     *string `json:"uuid,omitempty"`
-	MinToken string  `json:"min_token"`
+	OID      *string                    `json:"oid,omitempty"`
+	UUID     *string                    `json:"uuid,omitempty"`
+	MinToken string                     `json:"min_token"`
+	Extra    map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // VerifyAbhaProfile represents ABHA profile info during verification
 type VerifyAbhaProfile struct {
-	AbhaAddress string `json:"abha_address"`
-	Name        string `json:"name"`
-	KycVerified string `json:"kyc_verified"`
+	AbhaAddress string                     `json:"abha_address"`
+	Name        string                     `json:"name"`
+	KycVerified string                     `json:"kyc_verified"`
+	Extra       map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // DoesHealthIdExistRequest represents the request to check if health ID exists
@@ -203,19 +269,22 @@ type DoesHealthIdExistRequest struct {
 
 // DoesHealthIdExistResponse represents the response for health ID existence check
 type DoesHealthIdExistResponse struct {
-	Exists bool `json:"exists"`
+	Exists bool                       `json:"exists"`
+	Extra  map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // SuggestHealthIdResponse represents the response for suggested ABHA addresses
 type SuggestHealthIdResponse struct {
-	Suggestions []string `json:"suggestions"`
+	Suggestions []string                   `json:"suggestions"`
+	Extra       map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
 
 // PincodeData represents pincode resolution data
 type PincodeData struct {
-	Pincode   string `json:"pincode"`
-	DistCode  string `json:"dist_code"`
-	DistName  string `json:"dist_name"`
-	StateCode string `json:"state_code"`
-	StateName string `json:"state_name"`
+	Pincode   string                     `json:"pincode"`
+	DistCode  string                     `json:"dist_code"`
+	DistName  string                     `json:"dist_name"`
+	StateCode string                     `json:"state_code"`
+	StateName string                     `json:"state_name"`
+	Extra     map[string]json.RawMessage `json:"-"` // Fields not known to this SDK version
 }
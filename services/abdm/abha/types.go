@@ -1,5 +1,7 @@
 package abha
 
+import "slices"
+
 // SkipState represents the next screen the user should see in ABDM flows
 type SkipState string
 
@@ -22,6 +24,27 @@ const (
 	SkipStateAbhaCreate SkipState = "abha_create"
 )
 
+// knownSkipStates are the skip states this SDK version knows
+var knownSkipStates = []SkipState{
+	SkipStateAbhaEnd,
+	SkipStateConfirmMobileOTP,
+	SkipStateAbhaSelect,
+	SkipStateAbhaCreate,
+}
+
+// KnownSkipStates returns the skip states this SDK version knows
+func KnownSkipStates() []SkipState {
+	return slices.Clone(knownSkipStates)
+}
+
+// IsKnown returns true if the skip state is one of KnownSkipStates. ABDM may
+// add states before the SDK is updated; an unknown state still decodes, keeps
+// its value and requires user action, so callers can log it or show a
+// generic screen instead of treating it as a known step.
+func (s SkipState) IsKnown() bool {
+	return slices.Contains(knownSkipStates, s)
+}
+
 // String returns the string representation of the SkipState
 func (s SkipState) String() string {
 	return string(s)
@@ -32,7 +55,9 @@ func (s SkipState) IsComplete() bool {
 	return s == SkipStateAbhaEnd
 }
 
-// RequiresUserAction returns true if the skip state requires further user interaction
+// RequiresUserAction returns true if the skip state requires further user
+// interaction. It is also true for unknown states; use NextAction to tell
+// them apart.
 func (s SkipState) RequiresUserAction() bool {
 	return s != SkipStateAbhaEnd
 }
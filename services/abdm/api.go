@@ -0,0 +1,57 @@
+package abdm
+
+import (
+	"context"
+
+	"github.com/eka-care/eka-sdk-go/internal/interfaces"
+	"github.com/eka-care/eka-sdk-go/services/abdm/abha/login"
+	"github.com/eka-care/eka-sdk-go/services/abdm/abha/profile"
+	"github.com/eka-care/eka-sdk-go/services/abdm/abha/registration"
+)
+
+//go:generate moq -rm -pkg mocks -out ../../mocks/abdm.go . LoginAPI RegistrationAPI ProfileAPI
+
+// LoginAPI is the ABHA login service, implemented by *login.Service
+type LoginAPI interface {
+	LoginInit(ctx context.Context, headers interfaces.Headers, req *login.InitLoginRequest, opts ...interfaces.RequestOption) (*login.InitLoginResponse, error)
+	LoginVerify(ctx context.Context, headers interfaces.Headers, req *login.VerifyLoginOTPRequest, opts ...interfaces.RequestOption) (*login.VerifyLoginOTPResponse, error)
+	LoginWithPHRAddress(ctx context.Context, headers interfaces.Headers, req *login.PhrAddressLoginRequest, opts ...interfaces.RequestOption) (*login.PhrAddressLoginResponse, error)
+}
+
+// RegistrationAPI is the ABHA registration service, implemented by *registration.Service
+type RegistrationAPI interface {
+	AadhaarInit(ctx context.Context, headers interfaces.Headers, req registration.InitRequest, opts ...interfaces.RequestOption) (*registration.InitResponse, error)
+	AadhaarVerify(ctx context.Context, headers interfaces.Headers, req registration.VerifyRequest, opts ...interfaces.RequestOption) (*registration.VerifyResponse, error)
+	AadhaarResend(ctx context.Context, headers interfaces.Headers, req registration.ResendRequest, opts ...interfaces.RequestOption) (*registration.ResendResponse, error)
+	AadhaarMobileVerify(ctx context.Context, headers interfaces.Headers, oid string, req registration.MobileVerifyRequest, opts ...interfaces.RequestOption) (*registration.MobileVerifyResponse, error)
+	AadhaarMobileResend(ctx context.Context, headers interfaces.Headers, oid string, req registration.MobileResendRequest, opts ...interfaces.RequestOption) (*registration.MobileResendResponse, error)
+	AadhaarCreatePHR(ctx context.Context, headers interfaces.Headers, req registration.CreateRequest, opts ...interfaces.RequestOption) (*registration.CreateResponse, error)
+	MobileInit(ctx context.Context, headers interfaces.Headers, req registration.MobileInitRequest, opts ...interfaces.RequestOption) (*registration.MobileInitResponse, error)
+	MobileVerify(ctx context.Context, headers interfaces.Headers, req registration.MobileVerifyOTPRequest, opts ...interfaces.RequestOption) (*registration.MobileVerifyOTPResponse, error)
+	MobileResend(ctx context.Context, headers interfaces.Headers, req registration.MobileResendOTPRequest, opts ...interfaces.RequestOption) (*registration.MobileResendOTPResponse, error)
+	MobileCreatePHR(ctx context.Context, headers interfaces.Headers, req registration.MobileCreateRequest, opts ...interfaces.RequestOption) (*registration.MobileCreateResponse, error)
+	CheckAbhaAddressExists(ctx context.Context, headers interfaces.Headers, req registration.DoesHealthIdExistRequest, opts ...interfaces.RequestOption) (*registration.DoesHealthIdExistResponse, error)
+	SuggestAbhaAddress(ctx context.Context, headers interfaces.Headers, firstName, middleName, lastName, dob, transactionID string, opts ...interfaces.RequestOption) (*registration.SuggestHealthIdResponse, error)
+	GetPincodeDetails(ctx context.Context, headers interfaces.Headers, pincode string, opts ...interfaces.RequestOption) (*registration.PincodeData, error)
+}
+
+// ProfileAPI is the ABHA profile service, implemented by *profile.Service
+type ProfileAPI interface {
+	GetProfile(ctx context.Context, headers interfaces.Headers, opts ...interfaces.RequestOption) (*profile.ProfileResponse, error)
+	GetAssetCard(ctx context.Context, headers interfaces.Headers, req *profile.AssetRequest, opts ...interfaces.RequestOption) (*profile.AssetCardResponse, error)
+	GetAssetQR(ctx context.Context, headers interfaces.Headers, req *profile.AssetRequest, opts ...interfaces.RequestOption) (*profile.AssetQRResponse, error)
+	UpdateProfile(ctx context.Context, headers interfaces.Headers, req *profile.UpdateProfileRequest, opts ...interfaces.RequestOption) error
+	DeleteProfile(ctx context.Context, headers interfaces.Headers, oid string, opts ...interfaces.RequestOption) error
+	KYCInit(ctx context.Context, headers interfaces.Headers, req *profile.KYCInitRequest, opts ...interfaces.RequestOption) (*profile.KYCInitResponse, error)
+	KYCResend(ctx context.Context, headers interfaces.Headers, req *profile.KYCResendRequest, opts ...interfaces.RequestOption) (*profile.KYCResendResponse, error)
+	KYCVerify(ctx context.Context, headers interfaces.Headers, req *profile.KYCVerifyRequest, opts ...interfaces.RequestOption) (*profile.KYCVerifyResponse, error)
+	SessionInit(ctx context.Context, headers interfaces.Headers, req *profile.SessionInitRequest, opts ...interfaces.RequestOption) (*profile.SessionInitResponse, error)
+	SessionVerify(ctx context.Context, headers interfaces.Headers, req *profile.SessionVerifyRequest, opts ...interfaces.RequestOption) (*profile.SessionVerifyResponse, error)
+}
+
+// Ensure the services implement the interfaces
+var (
+	_ LoginAPI        = (*login.Service)(nil)
+	_ RegistrationAPI = (*registration.Service)(nil)
+	_ ProfileAPI      = (*profile.Service)(nil)
+)
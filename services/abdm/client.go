@@ -6,6 +6,7 @@
 package abdm
 
 import (
+	"github.com/eka-care/eka-sdk-go/internal/http"
 	"github.com/eka-care/eka-sdk-go/internal/interfaces"
 	"github.com/eka-care/eka-sdk-go/internal/utils"
 	"github.com/eka-care/eka-sdk-go/services/abdm/abha/login"
@@ -16,39 +17,59 @@ import (
 // Client represents the ABDM services client
 // It organizes all ABDM-related services under a single interface
 type Client struct {
-	loginService        *login.Service
-	registrationService *registration.Service
-	profileService      *profile.Service
+	loginService        LoginAPI
+	registrationService RegistrationAPI
+	profileService      ProfileAPI
 	utilsService        *utils.Service
 }
 
 // NewClient creates a new ABDM client with the given configuration
-// The configuration is managed by the main SDK client
-func NewClient(config interfaces.Config) *Client {
+// The configuration and the HTTP client are managed by the main SDK client
+// and shared by every service
+func NewClient(config interfaces.Config, httpClient *http.Client) *Client {
 	return &Client{
-		loginService:        login.NewService(config),
-		registrationService: registration.NewService(config),
-		profileService:      profile.NewService(config),
-		utilsService:        utils.NewService(config),
+		loginService:        login.NewService(config, httpClient),
+		registrationService: registration.NewService(config, httpClient),
+		profileService:      profile.NewService(config, httpClient),
+		utilsService:        utils.NewService(config, httpClient),
+	}
+}
+
+// Services holds the implementations an ABDM client is built from
+type Services struct {
+	Login        LoginAPI
+	Registration RegistrationAPI
+	Profile      ProfileAPI
+}
+
+// NewClientFromServices creates an ABDM client from arbitrary service
+// implementations, e.g. the mocks in the mocks package, so that code
+// depending on *abdm.Client can be tested without an HTTP server
+func NewClientFromServices(services Services) *Client {
+	return &Client{
+		loginService:        services.Login,
+		registrationService: services.Registration,
+		profileService:      services.Profile,
 	}
 }
 
 // Login returns the ABDM login service
-func (c *Client) Login() *login.Service {
+func (c *Client) Login() LoginAPI {
 	return c.loginService
 }
 
 // Registration returns the ABDM registration service
-func (c *Client) Registration() *registration.Service {
+func (c *Client) Registration() RegistrationAPI {
 	return c.registrationService
 }
 
 // Profile returns the ABDM profile service
-func (c *Client) Profile() *profile.Service {
+func (c *Client) Profile() ProfileAPI {
 	return c.profileService
 }
 
-// Utils returns the ABDM utils service
+// Utils returns the ABDM utils service, or nil for clients created with
+// NewClientFromServices
 func (c *Client) Utils() *utils.Service {
 	return c.utilsService
 }
@@ -0,0 +1,209 @@
+package ekasdk
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/eka-care/eka-sdk-go/internal/logging"
+)
+
+// ErrInvalidConfig is matched, using errors.Is, by the errors returned by
+// NewClient, NewClientFromEnv and NewFromConfigFile for invalid settings
+var ErrInvalidConfig = stderrors.New("invalid client configuration")
+
+// FieldError describes an invalid setting. Field is the ClientOptions field,
+// e.g. MaxRetries or RateLimits[otp].Burst, or the environment variable it
+// was read from, e.g. EKA_TIMEOUT.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ConfigError collects every invalid setting found while creating a client
+type ConfigError struct {
+	Errors []*FieldError
+}
+
+func (e *ConfigError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%s: %s", ErrInvalidConfig, strings.Join(msgs, "; "))
+}
+
+// Is matches ErrInvalidConfig
+func (e *ConfigError) Is(target error) bool {
+	return target == ErrInvalidConfig
+}
+
+// Unwrap returns the individual field errors
+func (e *ConfigError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// configError returns a *ConfigError for errs, or nil if there are none
+func configError(errs []*FieldError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return &ConfigError{Errors: errs}
+}
+
+// NewClient creates a new Eka SDK client with the given options, returning a
+// *ConfigError that lists every invalid option instead of failing later at
+// Login or on the first call
+func NewClient(opts ...Option) (*Client, error) {
+	options := DefaultClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	if err := configError(validateOptions(options)); err != nil {
+		return nil, err
+	}
+	return newClient(options, nil), nil
+}
+
+// NewClientFromEnv is like NewFromEnv, followed by opts, but reports EKA_*
+// variables that cannot be parsed and validates the result like NewClient
+func NewClientFromEnv(opts ...Option) (*Client, error) {
+	options := DefaultClientOptions()
+	errs := applyEnv(options)
+	for _, opt := range opts {
+		opt(options)
+	}
+	if err := configError(append(errs, validateOptions(options)...)); err != nil {
+		return nil, err
+	}
+	return newClient(options, nil), nil
+}
+
+// knownEndpointGroups are the endpoint groups accepted in RateLimits
+var knownEndpointGroups = map[EndpointGroup]bool{
+	EndpointGroupAuth:              true,
+	EndpointGroupOTP:               true,
+	EndpointGroupProfileRead:       true,
+	EndpointGroupRegistrationWrite: true,
+	EndpointGroupDefault:           true,
+}
+
+// validateOptions returns every invalid option
+func validateOptions(o *ClientOptions) []*FieldError {
+	var errs []*FieldError
+	add := func(field, format string, args ...interface{}) {
+		errs = append(errs, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	switch o.Environment {
+	case EnvironmentProduction, EnvironmentDevelopment:
+	default:
+		add("Environment", "unknown environment %q, expected %q or %q", o.Environment, EnvironmentProduction, EnvironmentDevelopment)
+	}
+
+	// A credentials provider replaces the client credentials
+	if o.CredentialsProvider == nil {
+		if o.ClientID == "" {
+			add("ClientID", "is required; set EKA_CLIENT_ID or use WithClientID")
+		}
+		if o.ClientSecret == "" {
+			add("ClientSecret", "is required; set EKA_CLIENT_SECRET or use WithClientSecret")
+		}
+	}
+
+	if o.MaxRetries < 0 {
+		add("MaxRetries", "must not be negative, got %d", o.MaxRetries)
+	}
+	if o.Timeout < 0 {
+		add("Timeout", "must not be negative, got %s", o.Timeout)
+	}
+	if o.MaxBackoffDelay < 0 {
+		add("MaxBackoffDelay", "must not be negative, got %s", o.MaxBackoffDelay)
+	}
+	if o.RequestTimeout < 0 {
+		add("RequestTimeout", "must not be negative, got %s", o.RequestTimeout)
+	}
+	if o.ResponseTimeout < 0 {
+		add("ResponseTimeout", "must not be negative, got %s", o.ResponseTimeout)
+	}
+	if o.ConnectionTimeout < 0 {
+		add("ConnectionTimeout", "must not be negative, got %s", o.ConnectionTimeout)
+	}
+
+	if o.Logger == nil && !strings.EqualFold(strings.TrimSpace(o.LogLevel), logging.LevelOff) &&
+		!strings.EqualFold(strings.TrimSpace(o.LogLevel), "none") {
+		if _, err := logging.ParseLevel(o.LogLevel); err != nil {
+			add("LogLevel", "%v, expected debug, info, warn, error or off", err)
+		}
+	}
+
+	if o.HTTPClient == nil {
+		add("HTTPClient", "must not be nil")
+	}
+
+	if o.BaseURL != "" {
+		if u, err := url.Parse(o.BaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			add("BaseURL", "must be an absolute http or https URL, got %q", o.BaseURL)
+		}
+	}
+	for i, mw := range o.Middleware {
+		if mw == nil {
+			add(fmt.Sprintf("Middleware[%d]", i), "must not be nil")
+		}
+	}
+
+	for i, mw := range o.TransportMiddleware {
+		if mw == nil {
+			add(fmt.Sprintf("TransportMiddleware[%d]", i), "must not be nil")
+		}
+	}
+
+	for group, limit := range o.RateLimits {
+		field := fmt.Sprintf("RateLimits[%s]", group)
+		if !knownEndpointGroups[group] {
+			add(field, "unknown endpoint group")
+		}
+		if limit.Rate < 0 {
+			add(field+".Rate", "must not be negative, got %g", limit.Rate)
+		}
+		if limit.Burst < 0 {
+			add(field+".Burst", "must not be negative, got %d", limit.Burst)
+		}
+	}
+
+	if cb := o.CircuitBreaker; cb != nil {
+		if cb.FailureThreshold < 1 {
+			add("CircuitBreaker.FailureThreshold", "must be at least 1, got %d", cb.FailureThreshold)
+		}
+		if cb.OpenTimeout <= 0 {
+			add("CircuitBreaker.OpenTimeout", "must be positive, got %s", cb.OpenTimeout)
+		}
+		if cb.HalfOpenMaxRequests < 1 {
+			add("CircuitBreaker.HalfOpenMaxRequests", "must be at least 1, got %d", cb.HalfOpenMaxRequests)
+		}
+	}
+
+	return errs
+}
+
+// envError returns the error for an EKA_* variable that cannot be parsed
+func envError(name, value string, err error) *FieldError {
+	return &FieldError{Field: name, Message: fmt.Sprintf("cannot parse %q: %v", value, unwrapNumError(err))}
+}
+
+// unwrapNumError strips the strconv function name from parse errors
+func unwrapNumError(err error) error {
+	if inner := stderrors.Unwrap(err); inner != nil {
+		return inner
+	}
+	return err
+}